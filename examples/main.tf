@@ -16,8 +16,44 @@ resource "cribl_pipeline" "example" {
   id          = "test01"
   description = "Foo pipeline"
   timeout_ms  = 3000
-  #tags        = ["foo"]
+  stream_tags = ["foo"]
   output      = "default"
+
+  groups = {
+    enrichment = {
+      name        = "Enrichment"
+      description = "flip disabled to switch off the whole stage"
+      disabled    = false
+    }
+  }
+
+  functions = [
+    {
+      id       = "eval"
+      filter   = "true"
+      group_id = "enrichment"
+      conf = jsonencode({
+        add = [{ name = "env", value = "'test'" }]
+      })
+    },
+    {
+      filter = "true"
+      mask = {
+        rules = [
+          {
+            match_regex  = "/(password=)\\S+/g"
+            replace_expr = "`$${g1}*****`"
+          }
+        ]
+      }
+    },
+    {
+      filter      = "level == 'debug'"
+      description = "drop debug noise"
+      final       = true
+      drop        = {}
+    },
+  ]
 }
 
 resource "cribl_input_datagen" "example" {
@@ -34,17 +70,210 @@ resource "cribl_input_datagen" "example" {
     }
   ]
   
-  #streamtags     = ["datagen", "test"]
+  stream_tags    = ["datagen", "test"]
   send_to_routes = true
   pq_enabled     = false
-  #metadata       = ["source:datagen", "environment:test"]
   environment    = "default"
+
+  metadata = [
+    {
+      name  = "environment"
+      value = "'test'"
+    }
+  ]
+}
+
+resource "cribl_input_syslog" "example" {
+  id          = "syslog_example"
+  description = "syslog over udp and tcp"
+  host        = "0.0.0.0"
+  udp_port    = 9514
+  tcp_port    = 9514
+  pipeline    = cribl_pipeline.example.id
+
+  timestamp_timezone = "UTC"
+  keep_fields_list   = ["*"]
+  stream_tags        = ["syslog"]
+
+  tls = {
+    disabled = true
+  }
+
+  metadata = [
+    {
+      name  = "datacenter"
+      value = "'dc1'"
+    }
+  ]
+}
+
+resource "cribl_input_splunk_hec" "example" {
+  id             = "splunk_hec_example"
+  description    = "splunk forwarders over hec"
+  host           = "0.0.0.0"
+  port           = 8088
+  splunk_hec_api = "/services/collector"
+
+  allowed_indexes = ["main", "os_*"]
+
+  auth_tokens = [
+    {
+      description     = "universal forwarders"
+      token           = "00000000-0000-0000-0000-000000000000"
+      allowed_indexes = ["os_*"]
+    }
+  ]
+
+  access_control_allow_origin = ["https://*.example.com"]
+
+  send_to_routes = false
+  connections = [
+    {
+      pipeline = cribl_pipeline.example.id
+      output   = cribl_output_s3.example.id
+    }
+  ]
+
+  tls = {
+    disabled = true
+  }
+}
+
+resource "cribl_input_kafka" "example" {
+  id             = "kafka_in_example"
+  brokers        = ["kafka1.example.com:9093", "kafka2.example.com:9093"]
+  topics         = ["app-logs"]
+  group_id       = "cribl"
+  from_beginning = false
+
+  sasl = {
+    mechanism          = "plain"
+    auth_type          = "secret"
+    credentials_secret = "kafka_creds"
+  }
+
+  schema_registry = {
+    url = "https://schema-registry.example.com:8081"
+  }
+}
+
+resource "cribl_input_open_telemetry" "example" {
+  id           = "otel_in_example"
+  host         = "0.0.0.0"
+  port         = 4317
+  protocol     = "grpc"
+  otlp_version = "1.3.1"
+
+  extract_spans   = true
+  extract_metrics = true
+  extract_logs    = true
+
+  auth_type   = "textSecret"
+  text_secret = "otel_ingest_token"
+
+  tls = {
+    disabled = true
+  }
+}
+
+resource "cribl_input_s3" "example" {
+  id          = "s3_in_example"
+  description = "cloudtrail logs from s3 notifications"
+  queue_name  = "https://sqs.us-west-2.amazonaws.com/123456789012/cloudtrail-notifications"
+  region      = "us-west-2"
+  file_filter = "/\\.json\\.gz$/"
+
+  aws_authentication_method = "auto"
+  enable_assume_role        = true
+  assume_role_arn           = "arn:aws:iam::123456789012:role/cribl-reader"
+  enable_sqs_assume_role    = true
+
+  breaker_rulesets = ["AWS Ruleset"]
+  checkpointing = {
+    retries = 5
+  }
+
+  max_messages       = 10
+  visibility_timeout = 600
+  poll_timeout       = 10
+}
+
+resource "cribl_input_sqs" "example" {
+  id         = "sqs_in_example"
+  queue_name = "app-events"
+  queue_type = "standard"
+  region     = "us-west-2"
+
+  aws_authentication_method = "secret"
+  aws_secret                = "aws_credentials"
+
+  num_receivers = 2
+}
+
+resource "cribl_input_http" "example" {
+  id   = "http_example"
+  host = "0.0.0.0"
+  port = 10080
+
+  cribl_api      = "/cribl"
+  elastic_api    = "/elastic"
+  splunk_hec_api = "/services/collector"
+
+  auth_tokens_ext = [
+    {
+      token       = "changeme"
+      description = "billing service"
+      metadata = [
+        {
+          name  = "team"
+          value = "'billing'"
+        }
+      ]
+    }
+  ]
+
+  tls = {
+    disabled = true
+  }
+}
+
+resource "cribl_input_http_raw" "example" {
+  id   = "http_raw_example"
+  host = "0.0.0.0"
+  port = 10081
+
+  allowed_paths   = ["/hooks/*"]
+  allowed_methods = ["POST", "PUT"]
+  auth_tokens     = ["changeme"]
+
+  breaker_rulesets = [cribl_event_breaker_ruleset.example.id]
+
+  send_to_routes = false
+  connections = [
+    {
+      pipeline = cribl_pipeline.example.id
+      output   = cribl_output_webhook.example.id
+    }
+  ]
+}
+
+# any source type without a typed resource, configured with Cribl's own
+# attribute names
+resource "cribl_input" "example" {
+  id   = "tcp_json_example"
+  type = "tcp_json"
+
+  config = {
+    host        = "0.0.0.0"
+    port        = 10070
+    description = "raw json over tcp"
+    pipeline    = cribl_pipeline.example.id
+  }
 }
 
 resource "cribl_output_s3" "example" {
   id                        = "output_example"
   type                      = "s3"
-  default_id                = "output_example"
   description               = "S3 output that depends on test01 pipeline"
   bucket                    = "cribl-output-bucket"
   region                    = "us-west-2"
@@ -56,7 +285,7 @@ resource "cribl_output_s3" "example" {
   # Option 1: Using AWS roles (recommended for production)
   # No additional fields needed if using IAM roles
   # OR
-  # aws_secret = "my-aws-credentials"
+  # aws_secret = cribl_secret.example.id
   # OR
   # aws_api_key = "YOUR_ACCESS_KEY_ID"
   # aws_secret_key = "YOUR_SECRET_ACCESS_KEY"
@@ -81,3 +310,294 @@ resource "cribl_output_s3" "example" {
   ]
 }
 
+resource "cribl_output_splunk_lb" "example" {
+  id          = "splunk_lb_example"
+  description = "indexer cluster"
+  auth_token  = "00000000-0000-0000-0000-000000000000"
+
+  # set either hosts or indexer_discovery
+  hosts = [
+    {
+      host   = "idx1.example.com"
+      port   = 9997
+      weight = 1
+    },
+    {
+      host   = "idx2.example.com"
+      port   = 9997
+      weight = 2
+    }
+  ]
+
+  tls = {
+    disabled = true
+  }
+
+  on_backpressure = "queue"
+  pq = {
+    mode     = "backpressure"
+    max_size = "5GB"
+  }
+}
+
+resource "cribl_output_kafka" "example" {
+  id          = "kafka_out_example"
+  brokers     = ["kafka1.example.com:9093", "kafka2.example.com:9093"]
+  topic       = "cribl-events"
+  ack         = 1
+  compression = "gzip"
+
+  sasl = {
+    mechanism = "scram-sha-512"
+    username  = "cribl"
+    password  = "changeme"
+  }
+
+  tls = {
+    disabled = false
+  }
+
+  on_backpressure = "block"
+}
+
+resource "cribl_output_elastic" "example" {
+  id             = "elastic_example"
+  description    = "logs cluster"
+  url            = "https://elastic.example.com:9200/_bulk"
+  index          = "'logs-cribl'"
+  write_action   = "create"
+  include_doc_id = false
+  compress       = true
+
+  auth = {
+    auth_type   = "textSecret"
+    text_secret = "elastic_api_key"
+  }
+
+  extra_http_headers = [
+    {
+      name  = "X-Team"
+      value = "platform"
+    }
+  ]
+
+  on_backpressure = "queue"
+  pq = {
+    mode = "error"
+  }
+}
+
+resource "cribl_output_webhook" "example" {
+  id     = "webhook_example"
+  url    = "https://example.com/ingest"
+  method = "POST"
+  format = "custom"
+
+  custom_source_expression  = "JSON.stringify({ message: _raw })"
+  custom_payload_expression = "{ \"items\": [$${events}] }"
+
+  auth_type            = "oauth"
+  login_url            = "https://auth.example.com/oauth/token"
+  secret_param_name    = "client_secret"
+  secret               = "changeme"
+  token_attribute_name = "access_token"
+  auth_header_expr     = "`Bearer $${token}`"
+  oauth_params = [
+    {
+      name  = "client_id"
+      value = "cribl"
+    }
+  ]
+
+  extra_http_headers = [
+    {
+      name  = "X-Api-Key"
+      value = "changeme"
+    }
+  ]
+
+  response_retry_settings = [
+    {
+      http_status     = 429
+      initial_backoff = 1000
+      max_backoff     = 60000
+    }
+  ]
+  timeout_retry_settings = {}
+
+  tls = {
+    disabled = false
+  }
+}
+
+resource "cribl_output_open_telemetry" "example" {
+  id           = "otel_out_example"
+  protocol     = "http"
+  otlp_version = "1.3.1"
+  endpoint     = "https://otel-collector.example.com:4318"
+
+  http_compress = "gzip"
+
+  auth_type = "token"
+  token     = "changeme"
+
+  on_backpressure = "queue"
+}
+
+# any destination type without a typed resource
+resource "cribl_output" "example" {
+  id   = "loki_example"
+  type = "loki"
+
+  config = {
+    url     = "https://loki.example.com/loki/api/v1/push"
+    message = "_raw"
+  }
+}
+
+resource "cribl_route" "example" {
+  id       = "route_example"
+  name     = "datagen to s3"
+  filter   = "__inputId == 'datagen:${cribl_input_datagen.example.id}'"
+  pipeline = cribl_pipeline.example.id
+  output   = cribl_output_s3.example.id
+  final    = true
+
+  # place the route ahead of the catch-all route instead of appending it
+  before = "default"
+}
+
+# cribl_routes owns the whole routing table, so it can't be combined with
+# cribl_route entries on the same table. Adopt an existing table with:
+#   terraform import cribl_routes.example default
+# resource "cribl_routes" "example" {
+#   id = "default"
+#
+#   groups = {
+#     archive = {
+#       name        = "Archive"
+#       description = "Routes that only feed long term storage"
+#     }
+#   }
+#
+#   routes = [
+#     {
+#       id       = "route_example"
+#       name     = "datagen to s3"
+#       filter   = "__inputId == 'datagen:${cribl_input_datagen.example.id}'"
+#       pipeline = cribl_pipeline.example.id
+#       output   = cribl_output_s3.example.id
+#       group_id = "archive"
+#     },
+#     {
+#       id       = "default"
+#       name     = "default"
+#       pipeline = "main"
+#       output   = "default"
+#     },
+#   ]
+#
+#   comments = ["managed by terraform"]
+# }
+
+resource "cribl_lookup" "example" {
+  id          = "known_hosts.csv"
+  description = "hosts allowed to send syslog"
+  tags        = ["syslog"]
+  mode        = "memory"
+  content     = <<-EOT
+    host,owner
+    web01,platform
+    db01,data
+  EOT
+
+  # or upload a local file instead of content
+  # file = "${path.module}/lookups/known_hosts.csv"
+}
+
+resource "cribl_global_variable" "example" {
+  id          = "allowed_ports"
+  type        = "array"
+  value       = jsonencode([514, 6514])
+  description = "ports syslog senders may use"
+  tags        = ["syslog"]
+}
+
+resource "cribl_global_variable" "expression" {
+  id    = "is_allowed_port"
+  type  = "expression"
+  value = "C.vars.allowed_ports.includes(port)"
+  args = [
+    { name = "port", type = "number" },
+  ]
+}
+
+variable "aws_secret_access_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "cribl_secret" "example" {
+  id            = "my-aws-credentials"
+  secret_type   = "keypair"
+  description   = "keys for the s3 output"
+  api_key       = "YOUR_ACCESS_KEY_ID"
+  secret_key_wo = var.aws_secret_access_key
+
+  # bump to send a rotated secret_key_wo
+  secret_wo_version = 1
+}
+
+resource "cribl_event_breaker_ruleset" "example" {
+  id          = "hooks_ruleset"
+  description = "breaks webhook payloads"
+
+  rules = [
+    {
+      name      = "ndjson"
+      condition = "/^\\{/.test(_raw)"
+      type      = "regex"
+
+      event_breaker_regex = "/[\\n\\r]+(?!\\s)/"
+      timestamp_type      = "format"
+      timestamp_format    = "%Y-%m-%dT%H:%M:%S"
+      max_event_bytes     = 51200
+
+      fields = [
+        { name = "source", value = "'hooks'" },
+      ]
+    },
+    {
+      name = "batches"
+      type = "json_array"
+    },
+  ]
+}
+
+# knowledge objects can be adopted with e.g.
+#   terraform import cribl_regex.example ipv4
+resource "cribl_regex" "example" {
+  id          = "ipv4"
+  regex       = "/(?<ip>\\d{1,3}(?:\\.\\d{1,3}){3})/"
+  description = "first IPv4 address in the event"
+  sample_data = "connection from 10.0.0.1"
+  tags        = ["network"]
+}
+
+resource "cribl_grok_pattern_file" "example" {
+  id      = "app_patterns"
+  content = <<-EOT
+    APP_LEVEL (DEBUG|INFO|WARN|ERROR)
+    APP_LINE %%{TIMESTAMP_ISO8601:time} %%{APP_LEVEL:level} %%{GREEDYDATA:message}
+  EOT
+}
+
+resource "cribl_parser" "example" {
+  id          = "app_csv"
+  type        = "csv"
+  description = "app export rows"
+  conf = jsonencode({
+    fields = ["time", "level", "message"]
+  })
+}
@@ -0,0 +1,7 @@
+package cribl
+
+// wrapper.go is generated from the Cribl API spec. The types the spec refers
+// to with x-go-type live in types.go. The union field is exported so that
+// inputs and outputs can be sent as raw JSON.
+//go:generate go tool oapi-codegen -config oapi-codegen.yaml spec/cribl-apidocs-4.10.1-45136dbb.yml
+//go:generate perl -pi -e "s/\\bunion\\b/Union/g" wrapper.go
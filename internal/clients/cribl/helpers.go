@@ -7,6 +7,8 @@ import (
 	"net/http"
 )
 
+// HandleResult checks the response status and, when out is non-nil, decodes
+// the response body into it.
 func HandleResult(resp *http.Response, err error, out interface{}) error {
 	if err != nil {
 		return err
@@ -14,6 +16,9 @@ func HandleResult(resp *http.Response, err error, out interface{}) error {
 
 	if resp.StatusCode == http.StatusOK {
 		defer resp.Body.Close()
+		if out == nil {
+			return nil
+		}
 		if data, err := io.ReadAll(resp.Body); err != nil {
 			return err
 		} else if err := json.Unmarshal(data, out); err != nil {
@@ -0,0 +1,109 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AWSAuth holds the flat AWS credential attributes shared by the AWS based
+// sources and destinations, it's embedded in their models.
+type AWSAuth struct {
+	AwsAuthenticationMethod types.String  `tfsdk:"aws_authentication_method"`
+	AwsApiKey               types.String  `tfsdk:"aws_api_key"`
+	AwsSecretKey            types.String  `tfsdk:"aws_secret_key"`
+	AwsSecret               types.String  `tfsdk:"aws_secret"`
+	EnableAssumeRole        types.Bool    `tfsdk:"enable_assume_role"`
+	AssumeRoleArn           types.String  `tfsdk:"assume_role_arn"`
+	AssumeRoleExternalId    types.String  `tfsdk:"assume_role_external_id"`
+	DurationSeconds         types.Float32 `tfsdk:"duration_seconds"`
+}
+
+// criblAWSAuth holds the AWS credential fields as Cribl has them, under the
+// same names on every AWS based source and destination.
+type criblAWSAuth struct {
+	AuthenticationMethod *string
+	ApiKey               *string
+	SecretKey            *string
+	Secret               *string
+	EnableAssumeRole     *bool
+	AssumeRoleArn        *string
+	AssumeRoleExternalId *string
+	DurationSeconds      *float32
+}
+
+func (a *AWSAuth) toCribl() criblAWSAuth {
+	return criblAWSAuth{
+		AuthenticationMethod: a.AwsAuthenticationMethod.ValueStringPointer(),
+		ApiKey:               a.AwsApiKey.ValueStringPointer(),
+		SecretKey:            a.AwsSecretKey.ValueStringPointer(),
+		Secret:               a.AwsSecret.ValueStringPointer(),
+		EnableAssumeRole:     a.EnableAssumeRole.ValueBoolPointer(),
+		AssumeRoleArn:        a.AssumeRoleArn.ValueStringPointer(),
+		AssumeRoleExternalId: a.AssumeRoleExternalId.ValueStringPointer(),
+		DurationSeconds:      a.DurationSeconds.ValueFloat32Pointer(),
+	}
+}
+
+func (a *AWSAuth) fromCribl(model criblAWSAuth) {
+	a.AwsAuthenticationMethod = types.StringPointerValue(model.AuthenticationMethod)
+	// the access and secret keys come back encrypted, so the configured
+	// ones stay in state
+	a.AwsSecret = types.StringPointerValue(model.Secret)
+	a.EnableAssumeRole = types.BoolPointerValue(model.EnableAssumeRole)
+	a.AssumeRoleArn = types.StringPointerValue(model.AssumeRoleArn)
+	a.AssumeRoleExternalId = types.StringPointerValue(model.AssumeRoleExternalId)
+	a.DurationSeconds = types.Float32PointerValue(model.DurationSeconds)
+}
+
+// Validate checks that the attributes needed by aws_authentication_method
+// and enable_assume_role are set.
+func (a *AWSAuth) Validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if a.EnableAssumeRole.ValueBool() && a.AssumeRoleArn.IsNull() {
+		diags.AddAttributeError(
+			path.Root("assume_role_arn"),
+			"Missing assume role ARN",
+			"assume_role_arn must be set when enable_assume_role is true.",
+		)
+	}
+
+	if a.AwsAuthenticationMethod.IsNull() || a.AwsAuthenticationMethod.IsUnknown() {
+		return diags
+	}
+	method := a.AwsAuthenticationMethod.ValueString()
+	switch method {
+	case "auto":
+	case "manual":
+		for field, value := range map[string]types.String{
+			"aws_api_key":    a.AwsApiKey,
+			"aws_secret_key": a.AwsSecretKey,
+		} {
+			if value.IsNull() {
+				diags.AddAttributeError(
+					path.Root(field),
+					"Missing AWS credentials",
+					fmt.Sprintf("%s must be set when aws_authentication_method is manual.", field),
+				)
+			}
+		}
+	case "secret":
+		if a.AwsSecret.IsNull() {
+			diags.AddAttributeError(
+				path.Root("aws_secret"),
+				"Missing AWS credentials",
+				"aws_secret must be set when aws_authentication_method is secret.",
+			)
+		}
+	default:
+		diags.AddAttributeError(
+			path.Root("aws_authentication_method"),
+			"Invalid AWS authentication method",
+			fmt.Sprintf("aws_authentication_method must be one of auto, manual or secret, got %q.", method),
+		)
+	}
+	return diags
+}
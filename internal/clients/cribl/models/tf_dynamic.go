@@ -0,0 +1,296 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// configBody encodes config as a cribl object with the given id and type.
+func configBody(id, kind string, config types.Dynamic) ([]byte, error) {
+	body := map[string]interface{}{}
+	if !config.IsNull() {
+		value, err := dynamicToJSON(config)
+		if err != nil {
+			return nil, err
+		}
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("config must be an object, got %T", value)
+		}
+		body = obj
+	}
+	body["id"] = id
+	body["type"] = kind
+	return json.Marshal(body)
+}
+
+// configFromBody decodes a cribl object into its type and config. Only the
+// keys set in prior are kept, so defaults cribl fills in on the server don't
+// show up as changes. With a null prior, e.g. on import, the whole object is
+// returned.
+func configFromBody(ctx context.Context, data []byte, prior types.Dynamic) (string, types.Dynamic, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := map[string]interface{}{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		diags.AddError("Unable to decode Cribl object", err.Error())
+		return "", prior, diags
+	}
+	kind := fmt.Sprintf("%v", body["type"])
+	delete(body, "id")
+	delete(body, "type")
+
+	var value interface{} = body
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorValue, err := dynamicToJSON(prior)
+		if err != nil {
+			diags.AddError("Unable to decode prior config", err.Error())
+			return kind, prior, diags
+		}
+		value = projectJSON(priorValue, body)
+	}
+
+	var like attr.Value
+	if !prior.IsNull() && !prior.IsUnknown() {
+		like = prior.UnderlyingValue()
+	}
+	config, d := jsonToDynamic(ctx, value, like)
+	diags.Append(d...)
+	return kind, types.DynamicValue(config), diags
+}
+
+// projectJSON returns server limited to the object keys and list entries
+// present in prior.
+func projectJSON(prior, server interface{}) interface{} {
+	switch p := prior.(type) {
+	case map[string]interface{}:
+		s, ok := server.(map[string]interface{})
+		if !ok {
+			return server
+		}
+		out := map[string]interface{}{}
+		for k, pv := range p {
+			sv, ok := s[k]
+			if !ok {
+				continue
+			}
+			if pv == nil {
+				out[k] = nil
+				continue
+			}
+			out[k] = projectJSON(pv, sv)
+		}
+		return out
+	case []interface{}:
+		s, ok := server.([]interface{})
+		if !ok {
+			return server
+		}
+		out := make([]interface{}, len(s))
+		for i, sv := range s {
+			if i < len(p) && p[i] != nil {
+				out[i] = projectJSON(p[i], sv)
+				continue
+			}
+			out[i] = sv
+		}
+		return out
+	default:
+		return server
+	}
+}
+
+// dynamicToJSON converts a terraform value into the plain value
+// encoding/json would decode the same document into.
+func dynamicToJSON(v attr.Value) (interface{}, error) {
+	if v == nil || v.IsNull() {
+		return nil, nil
+	}
+	if v.IsUnknown() {
+		return nil, fmt.Errorf("value is not known yet")
+	}
+	switch value := v.(type) {
+	case basetypes.DynamicValue:
+		return dynamicToJSON(value.UnderlyingValue())
+	case basetypes.ObjectValue:
+		return attrMapToJSON(value.Attributes())
+	case basetypes.MapValue:
+		return attrMapToJSON(value.Elements())
+	case basetypes.ListValue:
+		return attrListToJSON(value.Elements())
+	case basetypes.SetValue:
+		return attrListToJSON(value.Elements())
+	case basetypes.TupleValue:
+		return attrListToJSON(value.Elements())
+	case basetypes.StringValue:
+		return value.ValueString(), nil
+	case basetypes.BoolValue:
+		return value.ValueBool(), nil
+	case basetypes.NumberValue:
+		return numberToJSON(value.ValueBigFloat()), nil
+	case basetypes.Int64Value:
+		return value.ValueInt64(), nil
+	case basetypes.Float64Value:
+		return value.ValueFloat64(), nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+func attrMapToJSON(values map[string]attr.Value) (interface{}, error) {
+	out := map[string]interface{}{}
+	for k, v := range values {
+		value, err := dynamicToJSON(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		out[k] = value
+	}
+	return out, nil
+}
+
+func attrListToJSON(values []attr.Value) (interface{}, error) {
+	out := make([]interface{}, 0, len(values))
+	for i, v := range values {
+		value, err := dynamicToJSON(v)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		out = append(out, value)
+	}
+	return out, nil
+}
+
+func numberToJSON(f *big.Float) json.Number {
+	if f.IsInt() {
+		i, _ := f.Int(nil)
+		return json.Number(i.String())
+	}
+	return json.Number(f.Text('g', -1))
+}
+
+// jsonToDynamic converts a decoded JSON value into a terraform value. Where
+// like is given its collection types are reused, so a value read back from
+// cribl has the same type as the configured one.
+func jsonToDynamic(ctx context.Context, v interface{}, like attr.Value) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if dynamic, ok := like.(basetypes.DynamicValue); ok {
+		like = dynamic.UnderlyingValue()
+	}
+
+	switch value := v.(type) {
+	case nil:
+		if like != nil {
+			return nullOf(ctx, like), diags
+		}
+		return types.StringNull(), diags
+	case map[string]interface{}:
+		var likeAttrs map[string]attr.Value
+		switch l := like.(type) {
+		case basetypes.ObjectValue:
+			likeAttrs = l.Attributes()
+		case basetypes.MapValue:
+			likeAttrs = l.Elements()
+		}
+		elements := map[string]attr.Value{}
+		elementTypes := map[string]attr.Type{}
+		for k, item := range value {
+			element, d := jsonToDynamic(ctx, item, likeAttrs[k])
+			diags.Append(d...)
+			elements[k] = element
+			elementTypes[k] = element.Type(ctx)
+		}
+		if l, ok := like.(basetypes.MapValue); ok {
+			if m, d := types.MapValue(l.ElementType(ctx), elements); !d.HasError() {
+				return m, diags
+			}
+		}
+		obj, d := types.ObjectValue(elementTypes, elements)
+		diags.Append(d...)
+		return obj, diags
+	case []interface{}:
+		var likeElements []attr.Value
+		switch l := like.(type) {
+		case basetypes.ListValue:
+			likeElements = l.Elements()
+		case basetypes.SetValue:
+			likeElements = l.Elements()
+		case basetypes.TupleValue:
+			likeElements = l.Elements()
+		}
+		elements := make([]attr.Value, 0, len(value))
+		elementTypes := make([]attr.Type, 0, len(value))
+		for i, item := range value {
+			var likeElement attr.Value
+			if i < len(likeElements) {
+				likeElement = likeElements[i]
+			} else if len(likeElements) > 0 {
+				likeElement = likeElements[0]
+			}
+			element, d := jsonToDynamic(ctx, item, likeElement)
+			diags.Append(d...)
+			elements = append(elements, element)
+			elementTypes = append(elementTypes, element.Type(ctx))
+		}
+		switch l := like.(type) {
+		case basetypes.ListValue:
+			if list, d := types.ListValue(l.ElementType(ctx), elements); !d.HasError() {
+				return list, diags
+			}
+		case basetypes.SetValue:
+			if set, d := types.SetValue(l.ElementType(ctx), elements); !d.HasError() {
+				return set, diags
+			}
+		}
+		tuple, d := types.TupleValue(elementTypes, elements)
+		diags.Append(d...)
+		return tuple, diags
+	case string:
+		return types.StringValue(value), diags
+	case bool:
+		return types.BoolValue(value), diags
+	case json.Number:
+		f, _, err := big.ParseFloat(value.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			diags.AddError("Unable to parse number", err.Error())
+			return types.NumberNull(), diags
+		}
+		return types.NumberValue(f), diags
+	case float64:
+		return types.NumberValue(big.NewFloat(value)), diags
+	default:
+		diags.AddError("Unsupported JSON value", fmt.Sprintf("%T", v))
+		return types.StringNull(), diags
+	}
+}
+
+func nullOf(ctx context.Context, like attr.Value) attr.Value {
+	switch l := like.(type) {
+	case basetypes.ObjectValue:
+		return types.ObjectNull(l.AttributeTypes(ctx))
+	case basetypes.MapValue:
+		return types.MapNull(l.ElementType(ctx))
+	case basetypes.ListValue:
+		return types.ListNull(l.ElementType(ctx))
+	case basetypes.SetValue:
+		return types.SetNull(l.ElementType(ctx))
+	case basetypes.TupleValue:
+		return types.TupleNull(l.ElementTypes(ctx))
+	case basetypes.BoolValue:
+		return types.BoolNull()
+	case basetypes.NumberValue:
+		return types.NumberNull()
+	default:
+		return types.StringNull()
+	}
+}
@@ -0,0 +1,189 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type EventBreakerRuleset struct {
+	ID           types.String       `tfsdk:"id"`
+	Description  types.String       `tfsdk:"description"`
+	MinRawLength types.Float32      `tfsdk:"min_raw_length"`
+	Tags         types.List         `tfsdk:"tags"`
+	Rules        []EventBreakerRule `tfsdk:"rules"`
+}
+
+type EventBreakerRule struct {
+	Name                 types.String        `tfsdk:"name"`
+	Condition            types.String        `tfsdk:"condition"`
+	Type                 types.String        `tfsdk:"type"`
+	Disabled             types.Bool          `tfsdk:"disabled"`
+	EventBreakerRegex    types.String        `tfsdk:"event_breaker_regex"`
+	TimestampAnchorRegex types.String        `tfsdk:"timestamp_anchor_regex"`
+	TimestampType        types.String        `tfsdk:"timestamp_type"`
+	TimestampFormat      types.String        `tfsdk:"timestamp_format"`
+	TimestampLength      types.Float32       `tfsdk:"timestamp_length"`
+	TimestampTimezone    types.String        `tfsdk:"timestamp_timezone"`
+	TimestampEarliest    types.String        `tfsdk:"timestamp_earliest"`
+	TimestampLatest      types.String        `tfsdk:"timestamp_latest"`
+	MaxEventBytes        types.Float32       `tfsdk:"max_event_bytes"`
+	ParserEnabled        types.Bool          `tfsdk:"parser_enabled"`
+	ShouldUseDataRaw     types.Bool          `tfsdk:"should_use_data_raw"`
+	Fields               []EventBreakerField `tfsdk:"fields"`
+}
+
+type EventBreakerField struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+var (
+	EventBreakerRuleTypes = []cribl.EventBreakerRulesetRulesType{
+		cribl.EventBreakerRulesetRulesTypeRegex,
+		cribl.EventBreakerRulesetRulesTypeJsonArray,
+		cribl.EventBreakerRulesetRulesTypeJson,
+		cribl.EventBreakerRulesetRulesTypeCsv,
+		cribl.EventBreakerRulesetRulesTypeTimestamp,
+		cribl.EventBreakerRulesetRulesTypeHeader,
+		cribl.EventBreakerRulesetRulesTypeAwsCloudtrail,
+		cribl.EventBreakerRulesetRulesTypeAwsVpcflow,
+	}
+	EventBreakerTimestampTypes = []cribl.EventBreakerRulesetRulesTimestampType{
+		cribl.EventBreakerRulesetRulesTimestampTypeAuto,
+		cribl.EventBreakerRulesetRulesTimestampTypeFormat,
+		cribl.EventBreakerRulesetRulesTimestampTypeCurrent,
+	}
+)
+
+func (e *EventBreakerRuleset) ToCriblEventBreakerRuleset() cribl.EventBreakerRuleset {
+	out := cribl.EventBreakerRuleset{
+		Id:           e.ID.ValueString(),
+		Description:  e.Description.ValueStringPointer(),
+		Lib:          lo.ToPtr(cribl.EventBreakerRulesetLibCustom),
+		MinRawLength: e.MinRawLength.ValueFloat32Pointer(),
+		Tags:         toCriblTags(e.Tags),
+		Rules:        &[]cribl.EventBreakerRulesetRule{},
+	}
+	for _, rule := range e.Rules {
+		entry := cribl.EventBreakerRulesetRule{
+			Name:                 rule.Name.ValueString(),
+			Condition:            rule.Condition.ValueString(),
+			Type:                 cribl.EventBreakerRulesetRulesType(rule.Type.ValueString()),
+			Disabled:             rule.Disabled.ValueBoolPointer(),
+			EventBreakerRegex:    rule.EventBreakerRegex.ValueStringPointer(),
+			TimestampAnchorRegex: rule.TimestampAnchorRegex.ValueString(),
+			Timestamp: cribl.EventBreakerRulesetRuleTimestamp{
+				Type:   cribl.EventBreakerRulesetRulesTimestampType(rule.TimestampType.ValueString()),
+				Format: rule.TimestampFormat.ValueStringPointer(),
+				Length: rule.TimestampLength.ValueFloat32Pointer(),
+			},
+			TimestampTimezone: rule.TimestampTimezone.ValueStringPointer(),
+			TimestampEarliest: rule.TimestampEarliest.ValueStringPointer(),
+			TimestampLatest:   rule.TimestampLatest.ValueStringPointer(),
+			MaxEventBytes:     rule.MaxEventBytes.ValueFloat32Pointer(),
+			ParserEnabled:     rule.ParserEnabled.ValueBoolPointer(),
+			ShouldUseDataRaw:  rule.ShouldUseDataRaw.ValueBoolPointer(),
+		}
+		if rule.Fields != nil {
+			entry.Fields = lo.ToPtr(lo.Map(rule.Fields, func(field EventBreakerField, _ int) cribl.EventBreakerRulesetRuleField {
+				return cribl.EventBreakerRulesetRuleField{
+					Name:  field.Name.ValueStringPointer(),
+					Value: field.Value.ValueString(),
+				}
+			}))
+		}
+		*out.Rules = append(*out.Rules, entry)
+	}
+	return out
+}
+
+func (e *EventBreakerRuleset) FromCriblEventBreakerRuleset(ruleset cribl.EventBreakerRuleset) {
+	e.ID = types.StringValue(ruleset.Id)
+	e.Description = types.StringPointerValue(ruleset.Description)
+	e.MinRawLength = types.Float32PointerValue(ruleset.MinRawLength)
+	e.Tags = fromCriblTags(ruleset.Tags)
+	e.Rules = nil
+	if ruleset.Rules == nil {
+		return
+	}
+	for _, rule := range *ruleset.Rules {
+		entry := EventBreakerRule{
+			Name:                 types.StringValue(rule.Name),
+			Condition:            types.StringValue(rule.Condition),
+			Type:                 types.StringValue(string(rule.Type)),
+			Disabled:             types.BoolValue(lo.FromPtr(rule.Disabled)),
+			EventBreakerRegex:    types.StringPointerValue(rule.EventBreakerRegex),
+			TimestampAnchorRegex: types.StringValue(rule.TimestampAnchorRegex),
+			TimestampType:        types.StringValue(string(rule.Timestamp.Type)),
+			TimestampFormat:      types.StringPointerValue(rule.Timestamp.Format),
+			TimestampLength:      types.Float32PointerValue(rule.Timestamp.Length),
+			TimestampTimezone:    types.StringPointerValue(rule.TimestampTimezone),
+			TimestampEarliest:    types.StringPointerValue(rule.TimestampEarliest),
+			TimestampLatest:      types.StringPointerValue(rule.TimestampLatest),
+			MaxEventBytes:        types.Float32PointerValue(rule.MaxEventBytes),
+			ParserEnabled:        types.BoolValue(lo.FromPtr(rule.ParserEnabled)),
+			ShouldUseDataRaw:     types.BoolValue(lo.FromPtr(rule.ShouldUseDataRaw)),
+		}
+		if rule.Fields != nil && len(*rule.Fields) > 0 {
+			entry.Fields = lo.Map(*rule.Fields, func(field cribl.EventBreakerRulesetRuleField, _ int) EventBreakerField {
+				return EventBreakerField{
+					Name:  types.StringPointerValue(field.Name),
+					Value: types.StringValue(field.Value),
+				}
+			})
+		}
+		e.Rules = append(e.Rules, entry)
+	}
+}
+
+// Validate checks the rule and timestamp types, and that every rule has the
+// attributes its type needs.
+func (e *EventBreakerRuleset) Validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, rule := range e.Rules {
+		rulePath := path.Root("rules").AtListIndex(i)
+		if !rule.Type.IsNull() && !rule.Type.IsUnknown() {
+			ruleType := cribl.EventBreakerRulesetRulesType(rule.Type.ValueString())
+			if !lo.Contains(EventBreakerRuleTypes, ruleType) {
+				diags.AddAttributeError(
+					rulePath.AtName("type"),
+					"Invalid event breaker type",
+					fmt.Sprintf("type must be one of %v, got %q.", EventBreakerRuleTypes, ruleType),
+				)
+			}
+			if ruleType == cribl.EventBreakerRulesetRulesTypeRegex && rule.EventBreakerRegex.IsNull() {
+				diags.AddAttributeError(
+					rulePath.AtName("event_breaker_regex"),
+					"Missing event breaker regex",
+					"event_breaker_regex must be set when type is regex.",
+				)
+			}
+		}
+
+		if rule.TimestampType.IsNull() || rule.TimestampType.IsUnknown() {
+			continue
+		}
+		timestampType := cribl.EventBreakerRulesetRulesTimestampType(rule.TimestampType.ValueString())
+		if !lo.Contains(EventBreakerTimestampTypes, timestampType) {
+			diags.AddAttributeError(
+				rulePath.AtName("timestamp_type"),
+				"Invalid timestamp type",
+				fmt.Sprintf("timestamp_type must be one of %v, got %q.", EventBreakerTimestampTypes, timestampType),
+			)
+		}
+		if timestampType == cribl.EventBreakerRulesetRulesTimestampTypeFormat && rule.TimestampFormat.IsNull() {
+			diags.AddAttributeError(
+				rulePath.AtName("timestamp_format"),
+				"Missing timestamp format",
+				"timestamp_format must be set when timestamp_type is format.",
+			)
+		}
+	}
+	return diags
+}
@@ -0,0 +1,186 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type GlobalVariable struct {
+	ID             types.String        `tfsdk:"id"`
+	Type           types.String        `tfsdk:"type"`
+	Value          types.String        `tfsdk:"value"`
+	EncryptedValue types.String        `tfsdk:"encrypted_value"`
+	Description    types.String        `tfsdk:"description"`
+	Args           []GlobalVariableArg `tfsdk:"args"`
+	Tags           types.List          `tfsdk:"tags"`
+}
+
+type GlobalVariableArg struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+var GlobalVariableTypes = []cribl.GlobalVarType{
+	cribl.GlobalVarTypeNumber,
+	cribl.GlobalVarTypeString,
+	cribl.GlobalVarTypeBoolean,
+	cribl.GlobalVarTypeObject,
+	cribl.GlobalVarTypeArray,
+	cribl.GlobalVarTypeExpression,
+	cribl.GlobalVarTypeEncryptedString,
+}
+
+func (g *GlobalVariable) ToCriblGlobalVar() cribl.GlobalVar {
+	out := cribl.GlobalVar{
+		Id:          g.ID.ValueString(),
+		Type:        cribl.GlobalVarType(g.Type.ValueString()),
+		Value:       g.Value.ValueString(),
+		Description: g.Description.ValueStringPointer(),
+		Tags:        toCriblTags(g.Tags),
+	}
+	if out.Type == cribl.GlobalVarTypeEncryptedString {
+		out.Value = g.EncryptedValue.ValueString()
+	}
+	if g.Args != nil {
+		out.Args = lo.ToPtr(lo.Map(g.Args, func(arg GlobalVariableArg, _ int) cribl.GlobalVarArg {
+			return cribl.GlobalVarArg{
+				Name: arg.Name.ValueString(),
+				Type: arg.Type.ValueStringPointer(),
+			}
+		}))
+	}
+	return out
+}
+
+// FromCriblGlobalVar reads back the variable. Cribl returns encrypted values
+// encrypted, so the configured one is kept, and object and array values are
+// only replaced when they are no longer semantically equal JSON.
+func (g *GlobalVariable) FromCriblGlobalVar(ctx context.Context, v cribl.GlobalVar) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	g.ID = types.StringValue(v.Id)
+	g.Type = types.StringValue(string(v.Type))
+	g.Description = types.StringPointerValue(v.Description)
+	g.Tags = fromCriblTags(v.Tags)
+	g.Args = nil
+	if v.Args != nil && len(*v.Args) > 0 {
+		g.Args = lo.Map(*v.Args, func(arg cribl.GlobalVarArg, _ int) GlobalVariableArg {
+			return GlobalVariableArg{
+				Name: types.StringValue(arg.Name),
+				Type: types.StringPointerValue(arg.Type),
+			}
+		})
+	}
+
+	switch v.Type {
+	case cribl.GlobalVarTypeEncryptedString:
+		g.Value = types.StringNull()
+	case cribl.GlobalVarTypeObject, cribl.GlobalVarTypeArray:
+		if g.Value.IsNull() {
+			g.Value = types.StringValue(v.Value)
+			break
+		}
+		equal, d := jsontypes.NewNormalizedValue(g.Value.ValueString()).StringSemanticEquals(ctx, jsontypes.NewNormalizedValue(v.Value))
+		diags.Append(d...)
+		if !equal {
+			g.Value = types.StringValue(v.Value)
+		}
+	default:
+		g.Value = types.StringValue(v.Value)
+	}
+	return diags
+}
+
+// Validate checks that the value matches the declared type.
+func (g *GlobalVariable) Validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if g.Type.IsUnknown() {
+		return diags
+	}
+	typ := cribl.GlobalVarType(g.Type.ValueString())
+	if !lo.Contains(GlobalVariableTypes, typ) {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Invalid global variable type",
+			fmt.Sprintf("type must be one of %v, got %q.", GlobalVariableTypes, typ),
+		)
+		return diags
+	}
+
+	if g.Args != nil && typ != cribl.GlobalVarTypeExpression {
+		diags.AddAttributeError(
+			path.Root("args"),
+			"Unexpected global variable args",
+			"args can only be set when type is expression.",
+		)
+	}
+
+	if typ == cribl.GlobalVarTypeEncryptedString {
+		if !g.Value.IsNull() {
+			diags.AddAttributeError(
+				path.Root("value"),
+				"Unexpected global variable value",
+				"Use encrypted_value instead of value when type is encrypted_string.",
+			)
+		}
+		if g.EncryptedValue.IsNull() {
+			diags.AddAttributeError(
+				path.Root("encrypted_value"),
+				"Missing global variable value",
+				"encrypted_value must be set when type is encrypted_string.",
+			)
+		}
+		return diags
+	}
+	if !g.EncryptedValue.IsNull() {
+		diags.AddAttributeError(
+			path.Root("encrypted_value"),
+			"Unexpected global variable value",
+			"encrypted_value can only be set when type is encrypted_string.",
+		)
+	}
+	if g.Value.IsNull() {
+		diags.AddAttributeError(
+			path.Root("value"),
+			"Missing global variable value",
+			fmt.Sprintf("value must be set when type is %s.", typ),
+		)
+		return diags
+	}
+	if g.Value.IsUnknown() {
+		return diags
+	}
+
+	value := g.Value.ValueString()
+	var err error
+	switch typ {
+	case cribl.GlobalVarTypeNumber:
+		_, err = strconv.ParseFloat(value, 64)
+	case cribl.GlobalVarTypeBoolean:
+		if value != "true" && value != "false" {
+			err = fmt.Errorf("expected true or false")
+		}
+	case cribl.GlobalVarTypeObject:
+		err = json.Unmarshal([]byte(value), &map[string]interface{}{})
+	case cribl.GlobalVarTypeArray:
+		err = json.Unmarshal([]byte(value), &[]interface{}{})
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("value"),
+			"Invalid global variable value",
+			fmt.Sprintf("value %q is not a valid %s: %v", value, typ, err),
+		)
+	}
+	return diags
+}
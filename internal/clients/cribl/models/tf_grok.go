@@ -0,0 +1,27 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+)
+
+type GrokPatternFile struct {
+	ID      types.String `tfsdk:"id"`
+	Content types.String `tfsdk:"content"`
+	Tags    types.List   `tfsdk:"tags"`
+}
+
+func (g *GrokPatternFile) ToCriblGrokFile() cribl.GrokFile {
+	return cribl.GrokFile{
+		Id:      g.ID.ValueString(),
+		Content: g.Content.ValueString(),
+		Size:    float32(len(g.Content.ValueString())),
+		Tags:    toCriblTags(g.Tags),
+	}
+}
+
+func (g *GrokPatternFile) FromCriblGrokFile(file cribl.GrokFile) {
+	g.ID = types.StringValue(file.Id)
+	g.Content = types.StringValue(file.Content)
+	g.Tags = fromCriblTags(file.Tags)
+}
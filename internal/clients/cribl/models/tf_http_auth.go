@@ -0,0 +1,133 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+)
+
+// HTTPAuth holds the flat authentication attributes shared by the HTTP based
+// sources and destinations, it's embedded in their models.
+type HTTPAuth struct {
+	AuthType           types.String     `tfsdk:"auth_type"`
+	Username           types.String     `tfsdk:"username"`
+	Password           types.String     `tfsdk:"password"`
+	Token              types.String     `tfsdk:"token"`
+	CredentialsSecret  types.String     `tfsdk:"credentials_secret"`
+	TextSecret         types.String     `tfsdk:"text_secret"`
+	LoginURL           types.String     `tfsdk:"login_url"`
+	SecretParamName    types.String     `tfsdk:"secret_param_name"`
+	Secret             types.String     `tfsdk:"secret"`
+	TokenAttributeName types.String     `tfsdk:"token_attribute_name"`
+	AuthHeaderExpr     types.String     `tfsdk:"auth_header_expr"`
+	TokenTimeoutSecs   types.Float32    `tfsdk:"token_timeout_secs"`
+	OauthParams        []ExtraHTTPField `tfsdk:"oauth_params"`
+	OauthHeaders       []ExtraHTTPField `tfsdk:"oauth_headers"`
+}
+
+// Validate checks that the attributes needed by auth_type are set.
+func (a *HTTPAuth) Validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+	if a.AuthType.IsNull() || a.AuthType.IsUnknown() {
+		return diags
+	}
+
+	required := map[string][]string{
+		"none":              {},
+		"basic":             {"username", "password"},
+		"credentialsSecret": {"credentials_secret"},
+		"token":             {"token"},
+		"textSecret":        {"text_secret"},
+		"oauth":             {"login_url", "secret_param_name", "secret", "token_attribute_name"},
+	}
+	values := map[string]types.String{
+		"username":             a.Username,
+		"password":             a.Password,
+		"credentials_secret":   a.CredentialsSecret,
+		"token":                a.Token,
+		"text_secret":          a.TextSecret,
+		"login_url":            a.LoginURL,
+		"secret_param_name":    a.SecretParamName,
+		"secret":               a.Secret,
+		"token_attribute_name": a.TokenAttributeName,
+	}
+	authType := a.AuthType.ValueString()
+	fields, ok := required[authType]
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("auth_type"),
+			"Invalid auth type",
+			fmt.Sprintf("auth_type must be one of none, basic, credentialsSecret, token, textSecret or oauth, got %q.", authType),
+		)
+		return diags
+	}
+	for _, field := range fields {
+		if values[field].IsNull() {
+			diags.AddAttributeError(
+				path.Root(field),
+				"Missing auth attribute",
+				fmt.Sprintf("%s must be set when auth_type is %s.", field, authType),
+			)
+		}
+	}
+	return diags
+}
+
+func toCriblOauthParams(params []ExtraHTTPField) *[]cribl.OauthParam {
+	if params == nil {
+		return nil
+	}
+	out := []cribl.OauthParam{}
+	for _, param := range params {
+		out = append(out, cribl.OauthParam{
+			Name:  param.Name.ValueString(),
+			Value: param.Value.ValueString(),
+		})
+	}
+	return &out
+}
+
+func fromCriblOauthParams(params *[]cribl.OauthParam) []ExtraHTTPField {
+	if params == nil {
+		return nil
+	}
+	out := []ExtraHTTPField{}
+	for _, param := range *params {
+		out = append(out, ExtraHTTPField{
+			Name:  types.StringValue(param.Name),
+			Value: types.StringValue(param.Value),
+		})
+	}
+	return out
+}
+
+func toCriblOauthHeaders(headers []ExtraHTTPField) *[]cribl.OauthHeader {
+	if headers == nil {
+		return nil
+	}
+	out := []cribl.OauthHeader{}
+	for _, header := range headers {
+		out = append(out, cribl.OauthHeader{
+			Name:  header.Name.ValueString(),
+			Value: header.Value.ValueString(),
+		})
+	}
+	return &out
+}
+
+func fromCriblOauthHeaders(headers *[]cribl.OauthHeader) []ExtraHTTPField {
+	if headers == nil {
+		return nil
+	}
+	out := []ExtraHTTPField{}
+	for _, header := range *headers {
+		out = append(out, ExtraHTTPField{
+			Name:  types.StringValue(header.Name),
+			Value: types.StringValue(header.Value),
+		})
+	}
+	return out
+}
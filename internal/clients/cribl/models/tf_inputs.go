@@ -1,6 +1,11 @@
 package models
 
 import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/noodahl-org/cribl/internal/clients/cribl"
 )
@@ -11,21 +16,60 @@ type Sample struct {
 }
 
 type Connection struct {
-	Output string
+	Output   types.String `tfsdk:"output"`
+	Pipeline types.String `tfsdk:"pipeline"`
+}
+
+type MetadataField struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+type InputPQ struct {
+	Mode            types.String  `tfsdk:"mode"`
+	MaxBufferSize   types.Float32 `tfsdk:"max_buffer_size"`
+	CommitFrequency types.Float32 `tfsdk:"commit_frequency"`
+	MaxFileSize     types.String  `tfsdk:"max_file_size"`
+	MaxSize         types.String  `tfsdk:"max_size"`
+	Path            types.String  `tfsdk:"path"`
+	Compress        types.String  `tfsdk:"compress"`
+}
+
+// InputPreprocess enables feeding the data through a custom command when
+// set.
+type InputPreprocess struct {
+	Command types.String `tfsdk:"command"`
+	Args    types.List   `tfsdk:"args"`
+}
+
+type InputTLS struct {
+	Disabled           types.Bool   `tfsdk:"disabled"`
+	CertificateName    types.String `tfsdk:"certificate_name"`
+	PrivKeyPath        types.String `tfsdk:"priv_key_path"`
+	Passphrase         types.String `tfsdk:"passphrase"`
+	CertPath           types.String `tfsdk:"cert_path"`
+	CaPath             types.String `tfsdk:"ca_path"`
+	RequestCert        types.Bool   `tfsdk:"request_cert"`
+	RejectUnauthorized types.Bool   `tfsdk:"reject_unauthorized"`
+	CommonNameRegex    types.String `tfsdk:"common_name_regex"`
+	MinVersion         types.String `tfsdk:"min_version"`
+	MaxVersion         types.String `tfsdk:"max_version"`
 }
 
 type InputDatagen struct {
-	ID          types.String `tfsdk:"id"`
-	Description types.String `tfsdk:"description"`
-	Environment types.String `tfsdk:"environment"`
-	Samples     []Sample     `tfsdk:"samples"`
-	//StreamTags   types.List   `tfsdk:"stream_tags"`
-	Type         types.String `tfsdk:"type"`
-	Disabled     types.Bool   `tfsdk:"disabled"`
-	PQEnabled    types.Bool   `tfsdk:"pq_enabled"`
-	SendToRoutes types.Bool   `tfsdk:"send_to_routes"`
-	//Connections  []Connection `tfsdk:"connections"`
-	Pipeline types.String `tfsdk:"pipeline"`
+	ID           types.String    `tfsdk:"id"`
+	Description  types.String    `tfsdk:"description"`
+	Environment  types.String    `tfsdk:"environment"`
+	Samples      []Sample        `tfsdk:"samples"`
+	StreamTags   types.List      `tfsdk:"stream_tags"`
+	Type         types.String    `tfsdk:"type"`
+	Disabled     types.Bool      `tfsdk:"disabled"`
+	PQEnabled    types.Bool      `tfsdk:"pq_enabled"`
+	PQ           *InputPQ        `tfsdk:"pq"`
+	SendToRoutes types.Bool      `tfsdk:"send_to_routes"`
+	Connections  []Connection    `tfsdk:"connections"`
+	Pipeline     types.String    `tfsdk:"pipeline"`
+	Metadata     []MetadataField `tfsdk:"metadata"`
 }
 
 func (i *InputDatagen) ToCriblInputDatagen() cribl.InputDatagen {
@@ -33,18 +77,20 @@ func (i *InputDatagen) ToCriblInputDatagen() cribl.InputDatagen {
 	out := cribl.InputDatagen{
 		Id:           i.ID.ValueStringPointer(),
 		Description:  i.Description.ValueStringPointer(),
-		Type:         cribl.InputDatagenType(*i.Type.ValueStringPointer()),
+		Type:         cribl.InputDatagenType("datagen"),
 		Environment:  i.Environment.ValueStringPointer(),
 		Disabled:     i.Disabled.ValueBoolPointer(),
 		PqEnabled:    (*cribl.InputDatagenPqEnabled)(i.PQEnabled.ValueBoolPointer()),
+		Pq:           i.PQ.toCribl(),
 		SendToRoutes: (*cribl.InputDatagenSendToRoutes)(i.SendToRoutes.ValueBoolPointer()),
+		Connections:  toCriblConnections(i.Connections),
 		Pipeline:     i.Pipeline.ValueStringPointer(),
+		Streamtags:   toStringSlice(i.StreamTags),
+		Metadata:     toCriblMetadata(i.Metadata),
+		Samples:      []cribl.InputDatagenSample{},
 	}
 	for _, sample := range i.Samples {
-		out.Samples = append(out.Samples, struct {
-			EventsPerSec float32 "json:\"eventsPerSec\""
-			Sample       string  "json:\"sample\""
-		}{
+		out.Samples = append(out.Samples, cribl.InputDatagenSample{
 			EventsPerSec: float32(sample.EventsPerSec.ValueInt64()),
 			Sample:       sample.Sample.ValueString(),
 		})
@@ -57,11 +103,20 @@ func (i *InputDatagen) FromCriblDagen(model cribl.InputDatagen) {
 	i.ID = types.StringPointerValue(model.Id)
 	i.Description = types.StringPointerValue(model.Description)
 	i.Environment = types.StringPointerValue(model.Environment)
-	i.Type = types.StringValue(string(model.Type))
+	// the type is always datagen, only mirror it back when the config
+	// spells it out
+	if !i.Type.IsNull() {
+		i.Type = types.StringValue(string(model.Type))
+	}
 	i.Disabled = types.BoolPointerValue(model.Disabled)
 	i.PQEnabled = types.BoolPointerValue((*bool)(model.PqEnabled))
+	i.PQ = fromCriblPQ(model.Pq)
 	i.SendToRoutes = types.BoolPointerValue((*bool)(model.SendToRoutes))
+	i.Connections = fromCriblConnections(model.Connections)
 	i.Pipeline = types.StringPointerValue(model.Pipeline)
+	i.StreamTags = fromStringSlice(model.Streamtags)
+	i.Metadata = fromCriblMetadata(model.Metadata)
+	i.Samples = nil
 	for _, sample := range model.Samples {
 		i.Samples = append(i.Samples, Sample{
 			EventsPerSec: types.Int64Value(int64(sample.EventsPerSec)),
@@ -69,3 +124,241 @@ func (i *InputDatagen) FromCriblDagen(model cribl.InputDatagen) {
 		})
 	}
 }
+
+// Input is a source of any type, configured through a free-form config
+// object in Cribl's own attribute names.
+type Input struct {
+	ID     types.String  `tfsdk:"id"`
+	Type   types.String  `tfsdk:"type"`
+	Config types.Dynamic `tfsdk:"config"`
+}
+
+func (i *Input) ToCriblInput() (cribl.Input, error) {
+	body, err := configBody(i.ID.ValueString(), i.Type.ValueString(), i.Config)
+	if err != nil {
+		return cribl.Input{}, err
+	}
+	return cribl.Input{Union: json.RawMessage(body)}, nil
+}
+
+func (i *Input) FromCriblInput(ctx context.Context, model json.RawMessage) diag.Diagnostics {
+	kind, config, diags := configFromBody(ctx, model, i.Config)
+	if diags.HasError() {
+		return diags
+	}
+	i.Type = types.StringValue(kind)
+	i.Config = config
+	return diags
+}
+
+// ValidateConnections checks that connections are only set when the source
+// skips the Routes, Cribl sends to the Routes unless send_to_routes is false
+// and ignores the connections in that case.
+func ValidateConnections(sendToRoutes types.Bool, connections []Connection) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if sendToRoutes.IsUnknown() {
+		return diags
+	}
+	if len(connections) > 0 && (sendToRoutes.IsNull() || sendToRoutes.ValueBool()) {
+		diags.AddAttributeError(
+			path.Root("send_to_routes"),
+			"Conflicting send_to_routes and connections",
+			"connections are only used when send_to_routes is false, set send_to_routes = false to send data straight to them.",
+		)
+	}
+	if len(connections) == 0 && !sendToRoutes.IsNull() && !sendToRoutes.ValueBool() {
+		diags.AddAttributeWarning(
+			path.Root("connections"),
+			"No connections configured",
+			"send_to_routes is false and no connections are set, data from this source won't be sent anywhere.",
+		)
+	}
+	return diags
+}
+
+func toCriblConnections(connections []Connection) *[]cribl.InputConnection {
+	if connections == nil {
+		return nil
+	}
+	out := []cribl.InputConnection{}
+	for _, connection := range connections {
+		out = append(out, cribl.InputConnection{
+			Output:   connection.Output.ValueString(),
+			Pipeline: connection.Pipeline.ValueStringPointer(),
+		})
+	}
+	return &out
+}
+
+func fromCriblConnections(connections *[]cribl.InputConnection) []Connection {
+	if connections == nil {
+		return nil
+	}
+	out := []Connection{}
+	for _, connection := range *connections {
+		out = append(out, Connection{
+			Output:   types.StringValue(connection.Output),
+			Pipeline: types.StringPointerValue(connection.Pipeline),
+		})
+	}
+	return out
+}
+
+func toCriblMetadata(fields []MetadataField) *[]cribl.InputMetadata {
+	if fields == nil {
+		return nil
+	}
+	out := []cribl.InputMetadata{}
+	for _, field := range fields {
+		out = append(out, cribl.InputMetadata{
+			Name:  field.Name.ValueString(),
+			Value: field.Value.ValueString(),
+		})
+	}
+	return &out
+}
+
+func fromCriblMetadata(fields *[]cribl.InputMetadata) []MetadataField {
+	if fields == nil {
+		return nil
+	}
+	out := []MetadataField{}
+	for _, field := range *fields {
+		out = append(out, MetadataField{
+			Name:  types.StringValue(field.Name),
+			Value: types.StringValue(field.Value),
+		})
+	}
+	return out
+}
+
+func (p *InputPQ) toCribl() *cribl.InputPq {
+	if p == nil {
+		return nil
+	}
+	return &cribl.InputPq{
+		Mode:            p.Mode.ValueStringPointer(),
+		MaxBufferSize:   p.MaxBufferSize.ValueFloat32Pointer(),
+		CommitFrequency: p.CommitFrequency.ValueFloat32Pointer(),
+		MaxFileSize:     p.MaxFileSize.ValueStringPointer(),
+		MaxSize:         p.MaxSize.ValueStringPointer(),
+		Path:            p.Path.ValueStringPointer(),
+		Compress:        p.Compress.ValueStringPointer(),
+	}
+}
+
+func fromCriblPQ(pq *cribl.InputPq) *InputPQ {
+	if pq == nil {
+		return nil
+	}
+	return &InputPQ{
+		Mode:            types.StringPointerValue(pq.Mode),
+		MaxBufferSize:   types.Float32PointerValue(pq.MaxBufferSize),
+		CommitFrequency: types.Float32PointerValue(pq.CommitFrequency),
+		MaxFileSize:     types.StringPointerValue(pq.MaxFileSize),
+		MaxSize:         types.StringPointerValue(pq.MaxSize),
+		Path:            types.StringPointerValue(pq.Path),
+		Compress:        types.StringPointerValue(pq.Compress),
+	}
+}
+
+func (p *InputPreprocess) toCribl() *cribl.InputPreprocess {
+	if p == nil {
+		return nil
+	}
+	return &cribl.InputPreprocess{
+		Disabled: false,
+		Command:  p.Command.ValueStringPointer(),
+		Args:     toStringSlice(p.Args),
+	}
+}
+
+func fromCriblPreprocess(preprocess *cribl.InputPreprocess) *InputPreprocess {
+	if preprocess == nil || preprocess.Disabled {
+		return nil
+	}
+	return &InputPreprocess{
+		Command: types.StringPointerValue(preprocess.Command),
+		Args:    fromStringSlice(preprocess.Args),
+	}
+}
+
+func (t *InputTLS) toCribl() *cribl.InputTlsServerSide {
+	if t == nil {
+		return nil
+	}
+	out := &cribl.InputTlsServerSide{
+		Disabled:        t.Disabled.ValueBoolPointer(),
+		CertificateName: t.CertificateName.ValueStringPointer(),
+		PrivKeyPath:     t.PrivKeyPath.ValueStringPointer(),
+		Passphrase:      t.Passphrase.ValueStringPointer(),
+		CertPath:        t.CertPath.ValueStringPointer(),
+		CaPath:          t.CaPath.ValueStringPointer(),
+		RequestCert:     t.RequestCert.ValueBoolPointer(),
+		MinVersion:      t.MinVersion.ValueStringPointer(),
+		MaxVersion:      t.MaxVersion.ValueStringPointer(),
+	}
+	if !t.RejectUnauthorized.IsNull() {
+		var v interface{} = t.RejectUnauthorized.ValueBool()
+		out.RejectUnauthorized = &v
+	}
+	if !t.CommonNameRegex.IsNull() {
+		var v interface{} = t.CommonNameRegex.ValueString()
+		out.CommonNameRegex = &v
+	}
+	return out
+}
+
+func fromCriblTLS(tls *cribl.InputTlsServerSide) *InputTLS {
+	if tls == nil {
+		return nil
+	}
+	out := &InputTLS{
+		Disabled:           types.BoolPointerValue(tls.Disabled),
+		CertificateName:    types.StringPointerValue(tls.CertificateName),
+		PrivKeyPath:        types.StringPointerValue(tls.PrivKeyPath),
+		Passphrase:         types.StringPointerValue(tls.Passphrase),
+		CertPath:           types.StringPointerValue(tls.CertPath),
+		CaPath:             types.StringPointerValue(tls.CaPath),
+		RequestCert:        types.BoolPointerValue(tls.RequestCert),
+		RejectUnauthorized: types.BoolNull(),
+		CommonNameRegex:    types.StringNull(),
+		MinVersion:         types.StringPointerValue(tls.MinVersion),
+		MaxVersion:         types.StringPointerValue(tls.MaxVersion),
+	}
+	// both are free-form in the api, but cribl only ever stores a bool and
+	// a regex string here
+	if tls.RejectUnauthorized != nil {
+		if v, ok := (*tls.RejectUnauthorized).(bool); ok {
+			out.RejectUnauthorized = types.BoolValue(v)
+		}
+	}
+	if tls.CommonNameRegex != nil {
+		if v, ok := (*tls.CommonNameRegex).(string); ok {
+			out.CommonNameRegex = types.StringValue(v)
+		}
+	}
+	return out
+}
+
+func toStringSlice(list types.List) *[]string {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	out := []string{}
+	for _, v := range list.Elements() {
+		if s, ok := v.(types.String); ok {
+			out = append(out, s.ValueString())
+		}
+	}
+	return &out
+}
+
+func fromStringSlice(values *[]string) types.List {
+	if values == nil {
+		return types.ListNull(types.StringType)
+	}
+	out, _ := types.ListValueFrom(context.Background(), types.StringType, *values)
+	return out
+}
@@ -0,0 +1,159 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type InputHttp struct {
+	ID                    types.String    `tfsdk:"id"`
+	Description           types.String    `tfsdk:"description"`
+	Disabled              types.Bool      `tfsdk:"disabled"`
+	Environment           types.String    `tfsdk:"environment"`
+	Pipeline              types.String    `tfsdk:"pipeline"`
+	StreamTags            types.List      `tfsdk:"stream_tags"`
+	SendToRoutes          types.Bool      `tfsdk:"send_to_routes"`
+	Connections           []Connection    `tfsdk:"connections"`
+	PQEnabled             types.Bool      `tfsdk:"pq_enabled"`
+	PQ                    *InputPQ        `tfsdk:"pq"`
+	Host                  types.String    `tfsdk:"host"`
+	Port                  types.Float32   `tfsdk:"port"`
+	AuthTokens            types.List      `tfsdk:"auth_tokens"`
+	AuthTokensExt         []AuthTokenExt  `tfsdk:"auth_tokens_ext"`
+	TLS                   *InputTLS       `tfsdk:"tls"`
+	MaxActiveReq          types.Float32   `tfsdk:"max_active_req"`
+	MaxRequestsPerSocket  types.Int64     `tfsdk:"max_requests_per_socket"`
+	EnableProxyHeader     types.Bool      `tfsdk:"enable_proxy_header"`
+	CaptureHeaders        types.Bool      `tfsdk:"capture_headers"`
+	ActivityLogSampleRate types.Float32   `tfsdk:"activity_log_sample_rate"`
+	RequestTimeout        types.Float32   `tfsdk:"request_timeout"`
+	SocketTimeout         types.Float32   `tfsdk:"socket_timeout"`
+	KeepAliveTimeout      types.Float32   `tfsdk:"keep_alive_timeout"`
+	EnableHealthCheck     types.Bool      `tfsdk:"enable_health_check"`
+	IPAllowlistRegex      types.String    `tfsdk:"ip_allowlist_regex"`
+	IPDenylistRegex       types.String    `tfsdk:"ip_denylist_regex"`
+	CriblAPI              types.String    `tfsdk:"cribl_api"`
+	ElasticAPI            types.String    `tfsdk:"elastic_api"`
+	SplunkHecAPI          types.String    `tfsdk:"splunk_hec_api"`
+	SplunkHecAcks         types.Bool      `tfsdk:"splunk_hec_acks"`
+	Metadata              []MetadataField `tfsdk:"metadata"`
+}
+
+// AuthTokenExt is a shared secret with fields to add to the events sent
+// with it.
+type AuthTokenExt struct {
+	Token       types.String    `tfsdk:"token"`
+	Description types.String    `tfsdk:"description"`
+	Metadata    []MetadataField `tfsdk:"metadata"`
+}
+
+func (i *InputHttp) ToCriblInputHttp() cribl.InputHttp {
+	out := cribl.InputHttp{
+		Id:                    i.ID.ValueStringPointer(),
+		Type:                  lo.ToPtr(cribl.InputHttpType("http")),
+		Description:           i.Description.ValueStringPointer(),
+		Disabled:              i.Disabled.ValueBoolPointer(),
+		Environment:           i.Environment.ValueStringPointer(),
+		Pipeline:              i.Pipeline.ValueStringPointer(),
+		Streamtags:            toStringSlice(i.StreamTags),
+		SendToRoutes:          (*cribl.InputHttpSendToRoutes)(i.SendToRoutes.ValueBoolPointer()),
+		Connections:           toCriblConnections(i.Connections),
+		PqEnabled:             (*cribl.InputHttpPqEnabled)(i.PQEnabled.ValueBoolPointer()),
+		Pq:                    i.PQ.toCribl(),
+		Host:                  i.Host.ValueString(),
+		Port:                  i.Port.ValueFloat32(),
+		AuthTokens:            toStringSlice(i.AuthTokens),
+		AuthTokensExt:         toCriblAuthTokensExt(i.AuthTokensExt),
+		Tls:                   i.TLS.toCribl(),
+		MaxActiveReq:          i.MaxActiveReq.ValueFloat32Pointer(),
+		EnableProxyHeader:     i.EnableProxyHeader.ValueBoolPointer(),
+		CaptureHeaders:        i.CaptureHeaders.ValueBoolPointer(),
+		ActivityLogSampleRate: i.ActivityLogSampleRate.ValueFloat32Pointer(),
+		RequestTimeout:        i.RequestTimeout.ValueFloat32Pointer(),
+		SocketTimeout:         i.SocketTimeout.ValueFloat32Pointer(),
+		KeepAliveTimeout:      i.KeepAliveTimeout.ValueFloat32Pointer(),
+		EnableHealthCheck:     i.EnableHealthCheck.ValueBoolPointer(),
+		IpAllowlistRegex:      i.IPAllowlistRegex.ValueStringPointer(),
+		IpDenylistRegex:       i.IPDenylistRegex.ValueStringPointer(),
+		CriblAPI:              i.CriblAPI.ValueStringPointer(),
+		ElasticAPI:            i.ElasticAPI.ValueStringPointer(),
+		SplunkHecAPI:          i.SplunkHecAPI.ValueStringPointer(),
+		SplunkHecAcks:         i.SplunkHecAcks.ValueBoolPointer(),
+		Metadata:              toCriblMetadata(i.Metadata),
+	}
+	if !i.MaxRequestsPerSocket.IsNull() {
+		out.MaxRequestsPerSocket = lo.ToPtr(int(i.MaxRequestsPerSocket.ValueInt64()))
+	}
+	return out
+}
+
+func (i *InputHttp) FromCriblInputHttp(model cribl.InputHttp) {
+	i.ID = types.StringPointerValue(model.Id)
+	i.Description = types.StringPointerValue(model.Description)
+	i.Disabled = types.BoolPointerValue(model.Disabled)
+	i.Environment = types.StringPointerValue(model.Environment)
+	i.Pipeline = types.StringPointerValue(model.Pipeline)
+	i.StreamTags = fromStringSlice(model.Streamtags)
+	i.SendToRoutes = types.BoolPointerValue((*bool)(model.SendToRoutes))
+	i.Connections = fromCriblConnections(model.Connections)
+	i.PQEnabled = types.BoolPointerValue((*bool)(model.PqEnabled))
+	i.PQ = fromCriblPQ(model.Pq)
+	i.Host = types.StringValue(model.Host)
+	i.Port = types.Float32Value(model.Port)
+	i.TLS = fromCriblTLS(model.Tls)
+	i.MaxActiveReq = types.Float32PointerValue(model.MaxActiveReq)
+	i.MaxRequestsPerSocket = types.Int64Null()
+	if model.MaxRequestsPerSocket != nil {
+		i.MaxRequestsPerSocket = types.Int64Value(int64(*model.MaxRequestsPerSocket))
+	}
+	i.EnableProxyHeader = types.BoolPointerValue(model.EnableProxyHeader)
+	i.CaptureHeaders = types.BoolPointerValue(model.CaptureHeaders)
+	i.ActivityLogSampleRate = types.Float32PointerValue(model.ActivityLogSampleRate)
+	i.RequestTimeout = types.Float32PointerValue(model.RequestTimeout)
+	i.SocketTimeout = types.Float32PointerValue(model.SocketTimeout)
+	i.KeepAliveTimeout = types.Float32PointerValue(model.KeepAliveTimeout)
+	i.EnableHealthCheck = types.BoolPointerValue(model.EnableHealthCheck)
+	i.IPAllowlistRegex = types.StringPointerValue(model.IpAllowlistRegex)
+	i.IPDenylistRegex = types.StringPointerValue(model.IpDenylistRegex)
+	i.CriblAPI = types.StringPointerValue(model.CriblAPI)
+	i.ElasticAPI = types.StringPointerValue(model.ElasticAPI)
+	i.SplunkHecAPI = types.StringPointerValue(model.SplunkHecAPI)
+	i.SplunkHecAcks = types.BoolPointerValue(model.SplunkHecAcks)
+	i.Metadata = fromCriblMetadata(model.Metadata)
+
+	// tokens are read back as stored so a token rotated or removed outside
+	// of terraform shows up as a change
+	i.AuthTokens = fromStringSlice(model.AuthTokens)
+	i.AuthTokensExt = fromCriblAuthTokensExt(model.AuthTokensExt)
+}
+
+func toCriblAuthTokensExt(tokens []AuthTokenExt) *[]cribl.InputAuthTokenExt {
+	if tokens == nil {
+		return nil
+	}
+	out := []cribl.InputAuthTokenExt{}
+	for _, token := range tokens {
+		out = append(out, cribl.InputAuthTokenExt{
+			Token:       token.Token.ValueString(),
+			Description: token.Description.ValueStringPointer(),
+			Metadata:    toCriblMetadata(token.Metadata),
+		})
+	}
+	return &out
+}
+
+func fromCriblAuthTokensExt(tokens *[]cribl.InputAuthTokenExt) []AuthTokenExt {
+	if tokens == nil {
+		return nil
+	}
+	out := []AuthTokenExt{}
+	for _, token := range *tokens {
+		out = append(out, AuthTokenExt{
+			Token:       types.StringValue(token.Token),
+			Description: types.StringPointerValue(token.Description),
+			Metadata:    fromCriblMetadata(token.Metadata),
+		})
+	}
+	return out
+}
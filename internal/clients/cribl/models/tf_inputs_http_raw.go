@@ -0,0 +1,119 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type InputHttpRaw struct {
+	ID                    types.String    `tfsdk:"id"`
+	Description           types.String    `tfsdk:"description"`
+	Disabled              types.Bool      `tfsdk:"disabled"`
+	Environment           types.String    `tfsdk:"environment"`
+	Pipeline              types.String    `tfsdk:"pipeline"`
+	StreamTags            types.List      `tfsdk:"stream_tags"`
+	SendToRoutes          types.Bool      `tfsdk:"send_to_routes"`
+	Connections           []Connection    `tfsdk:"connections"`
+	PQEnabled             types.Bool      `tfsdk:"pq_enabled"`
+	PQ                    *InputPQ        `tfsdk:"pq"`
+	Host                  types.String    `tfsdk:"host"`
+	Port                  types.Float32   `tfsdk:"port"`
+	AuthTokens            types.List      `tfsdk:"auth_tokens"`
+	AuthTokensExt         []AuthTokenExt  `tfsdk:"auth_tokens_ext"`
+	TLS                   *InputTLS       `tfsdk:"tls"`
+	MaxActiveReq          types.Float32   `tfsdk:"max_active_req"`
+	MaxRequestsPerSocket  types.Int64     `tfsdk:"max_requests_per_socket"`
+	EnableProxyHeader     types.Bool      `tfsdk:"enable_proxy_header"`
+	CaptureHeaders        types.Bool      `tfsdk:"capture_headers"`
+	ActivityLogSampleRate types.Float32   `tfsdk:"activity_log_sample_rate"`
+	RequestTimeout        types.Float32   `tfsdk:"request_timeout"`
+	SocketTimeout         types.Float32   `tfsdk:"socket_timeout"`
+	KeepAliveTimeout      types.Float32   `tfsdk:"keep_alive_timeout"`
+	EnableHealthCheck     types.Bool      `tfsdk:"enable_health_check"`
+	IPAllowlistRegex      types.String    `tfsdk:"ip_allowlist_regex"`
+	IPDenylistRegex       types.String    `tfsdk:"ip_denylist_regex"`
+	AllowedPaths          types.List      `tfsdk:"allowed_paths"`
+	AllowedMethods        types.List      `tfsdk:"allowed_methods"`
+	BreakerRulesets       types.List      `tfsdk:"breaker_rulesets"`
+	StaleChannelFlushMs   types.Float32   `tfsdk:"stale_channel_flush_ms"`
+	Metadata              []MetadataField `tfsdk:"metadata"`
+}
+
+func (i *InputHttpRaw) ToCriblInputHttpRaw() cribl.InputHttpRaw {
+	out := cribl.InputHttpRaw{
+		Id:                    i.ID.ValueStringPointer(),
+		Type:                  lo.ToPtr(cribl.InputHttpRawType("http_raw")),
+		Description:           i.Description.ValueStringPointer(),
+		Disabled:              i.Disabled.ValueBoolPointer(),
+		Environment:           i.Environment.ValueStringPointer(),
+		Pipeline:              i.Pipeline.ValueStringPointer(),
+		Streamtags:            toStringSlice(i.StreamTags),
+		SendToRoutes:          (*cribl.InputHttpRawSendToRoutes)(i.SendToRoutes.ValueBoolPointer()),
+		Connections:           toCriblConnections(i.Connections),
+		PqEnabled:             (*cribl.InputHttpRawPqEnabled)(i.PQEnabled.ValueBoolPointer()),
+		Pq:                    i.PQ.toCribl(),
+		Host:                  i.Host.ValueString(),
+		Port:                  i.Port.ValueFloat32(),
+		AuthTokens:            toStringSlice(i.AuthTokens),
+		AuthTokensExt:         toCriblAuthTokensExt(i.AuthTokensExt),
+		Tls:                   i.TLS.toCribl(),
+		MaxActiveReq:          i.MaxActiveReq.ValueFloat32Pointer(),
+		EnableProxyHeader:     i.EnableProxyHeader.ValueBoolPointer(),
+		CaptureHeaders:        i.CaptureHeaders.ValueBoolPointer(),
+		ActivityLogSampleRate: i.ActivityLogSampleRate.ValueFloat32Pointer(),
+		RequestTimeout:        i.RequestTimeout.ValueFloat32Pointer(),
+		SocketTimeout:         i.SocketTimeout.ValueFloat32Pointer(),
+		KeepAliveTimeout:      i.KeepAliveTimeout.ValueFloat32Pointer(),
+		EnableHealthCheck:     i.EnableHealthCheck.ValueBoolPointer(),
+		IpAllowlistRegex:      i.IPAllowlistRegex.ValueStringPointer(),
+		IpDenylistRegex:       i.IPDenylistRegex.ValueStringPointer(),
+		AllowedPaths:          toStringSlice(i.AllowedPaths),
+		AllowedMethods:        toStringSlice(i.AllowedMethods),
+		BreakerRulesets:       toStringSlice(i.BreakerRulesets),
+		StaleChannelFlushMs:   i.StaleChannelFlushMs.ValueFloat32Pointer(),
+		Metadata:              toCriblMetadata(i.Metadata),
+	}
+	if !i.MaxRequestsPerSocket.IsNull() {
+		out.MaxRequestsPerSocket = lo.ToPtr(int(i.MaxRequestsPerSocket.ValueInt64()))
+	}
+	return out
+}
+
+func (i *InputHttpRaw) FromCriblInputHttpRaw(model cribl.InputHttpRaw) {
+	i.ID = types.StringPointerValue(model.Id)
+	i.Description = types.StringPointerValue(model.Description)
+	i.Disabled = types.BoolPointerValue(model.Disabled)
+	i.Environment = types.StringPointerValue(model.Environment)
+	i.Pipeline = types.StringPointerValue(model.Pipeline)
+	i.StreamTags = fromStringSlice(model.Streamtags)
+	i.SendToRoutes = types.BoolPointerValue((*bool)(model.SendToRoutes))
+	i.Connections = fromCriblConnections(model.Connections)
+	i.PQEnabled = types.BoolPointerValue((*bool)(model.PqEnabled))
+	i.PQ = fromCriblPQ(model.Pq)
+	i.Host = types.StringValue(model.Host)
+	i.Port = types.Float32Value(model.Port)
+	i.TLS = fromCriblTLS(model.Tls)
+	i.MaxActiveReq = types.Float32PointerValue(model.MaxActiveReq)
+	i.MaxRequestsPerSocket = types.Int64Null()
+	if model.MaxRequestsPerSocket != nil {
+		i.MaxRequestsPerSocket = types.Int64Value(int64(*model.MaxRequestsPerSocket))
+	}
+	i.EnableProxyHeader = types.BoolPointerValue(model.EnableProxyHeader)
+	i.CaptureHeaders = types.BoolPointerValue(model.CaptureHeaders)
+	i.ActivityLogSampleRate = types.Float32PointerValue(model.ActivityLogSampleRate)
+	i.RequestTimeout = types.Float32PointerValue(model.RequestTimeout)
+	i.SocketTimeout = types.Float32PointerValue(model.SocketTimeout)
+	i.KeepAliveTimeout = types.Float32PointerValue(model.KeepAliveTimeout)
+	i.EnableHealthCheck = types.BoolPointerValue(model.EnableHealthCheck)
+	i.IPAllowlistRegex = types.StringPointerValue(model.IpAllowlistRegex)
+	i.IPDenylistRegex = types.StringPointerValue(model.IpDenylistRegex)
+	i.AllowedPaths = fromStringSlice(model.AllowedPaths)
+	i.AllowedMethods = fromStringSlice(model.AllowedMethods)
+	i.BreakerRulesets = fromStringSlice(model.BreakerRulesets)
+	i.StaleChannelFlushMs = types.Float32PointerValue(model.StaleChannelFlushMs)
+	i.Metadata = fromCriblMetadata(model.Metadata)
+
+	i.AuthTokens = fromStringSlice(model.AuthTokens)
+	i.AuthTokensExt = fromCriblAuthTokensExt(model.AuthTokensExt)
+}
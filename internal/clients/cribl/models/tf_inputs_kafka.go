@@ -0,0 +1,121 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type InputKafka struct {
+	ID                        types.String         `tfsdk:"id"`
+	Description               types.String         `tfsdk:"description"`
+	Disabled                  types.Bool           `tfsdk:"disabled"`
+	Environment               types.String         `tfsdk:"environment"`
+	Pipeline                  types.String         `tfsdk:"pipeline"`
+	StreamTags                types.List           `tfsdk:"stream_tags"`
+	SendToRoutes              types.Bool           `tfsdk:"send_to_routes"`
+	Connections               []Connection         `tfsdk:"connections"`
+	PQEnabled                 types.Bool           `tfsdk:"pq_enabled"`
+	PQ                        *InputPQ             `tfsdk:"pq"`
+	Brokers                   types.List           `tfsdk:"brokers"`
+	Topics                    types.List           `tfsdk:"topics"`
+	GroupID                   types.String         `tfsdk:"group_id"`
+	FromBeginning             types.Bool           `tfsdk:"from_beginning"`
+	SessionTimeout            types.Float32        `tfsdk:"session_timeout"`
+	RebalanceTimeout          types.Float32        `tfsdk:"rebalance_timeout"`
+	HeartbeatInterval         types.Float32        `tfsdk:"heartbeat_interval"`
+	AutoCommitInterval        types.Float32        `tfsdk:"auto_commit_interval"`
+	AutoCommitThreshold       types.Float32        `tfsdk:"auto_commit_threshold"`
+	MaxBytesPerPartition      types.Float32        `tfsdk:"max_bytes_per_partition"`
+	MaxBytes                  types.Float32        `tfsdk:"max_bytes"`
+	MaxSocketErrors           types.Float32        `tfsdk:"max_socket_errors"`
+	ConnectionTimeout         types.Float32        `tfsdk:"connection_timeout"`
+	RequestTimeout            types.Float32        `tfsdk:"request_timeout"`
+	MaxRetries                types.Float32        `tfsdk:"max_retries"`
+	MaxBackOff                types.Float32        `tfsdk:"max_back_off"`
+	InitialBackoff            types.Float32        `tfsdk:"initial_backoff"`
+	BackoffRate               types.Float32        `tfsdk:"backoff_rate"`
+	AuthenticationTimeout     types.Float32        `tfsdk:"authentication_timeout"`
+	ReauthenticationThreshold types.Float32        `tfsdk:"reauthentication_threshold"`
+	SASL                      *KafkaSASL           `tfsdk:"sasl"`
+	TLS                       *OutputTLS           `tfsdk:"tls"`
+	SchemaRegistry            *KafkaSchemaRegistry `tfsdk:"schema_registry"`
+	Metadata                  []MetadataField      `tfsdk:"metadata"`
+}
+
+func (i *InputKafka) ToCriblInputKafka() cribl.InputKafka {
+	return cribl.InputKafka{
+		Id:                        i.ID.ValueStringPointer(),
+		Type:                      lo.ToPtr(cribl.InputKafkaTypeKafka),
+		Description:               i.Description.ValueStringPointer(),
+		Disabled:                  i.Disabled.ValueBoolPointer(),
+		Environment:               i.Environment.ValueStringPointer(),
+		Pipeline:                  i.Pipeline.ValueStringPointer(),
+		Streamtags:                toStringSlice(i.StreamTags),
+		SendToRoutes:              (*cribl.InputKafkaSendToRoutes)(i.SendToRoutes.ValueBoolPointer()),
+		Connections:               toCriblConnections(i.Connections),
+		PqEnabled:                 (*cribl.InputKafkaPqEnabled)(i.PQEnabled.ValueBoolPointer()),
+		Pq:                        i.PQ.toCribl(),
+		Brokers:                   lo.FromPtr(toStringSlice(i.Brokers)),
+		Topics:                    lo.FromPtr(toStringSlice(i.Topics)),
+		GroupId:                   i.GroupID.ValueStringPointer(),
+		FromBeginning:             i.FromBeginning.ValueBoolPointer(),
+		SessionTimeout:            i.SessionTimeout.ValueFloat32Pointer(),
+		RebalanceTimeout:          i.RebalanceTimeout.ValueFloat32Pointer(),
+		HeartbeatInterval:         i.HeartbeatInterval.ValueFloat32Pointer(),
+		AutoCommitInterval:        i.AutoCommitInterval.ValueFloat32Pointer(),
+		AutoCommitThreshold:       i.AutoCommitThreshold.ValueFloat32Pointer(),
+		MaxBytesPerPartition:      i.MaxBytesPerPartition.ValueFloat32Pointer(),
+		MaxBytes:                  i.MaxBytes.ValueFloat32Pointer(),
+		MaxSocketErrors:           i.MaxSocketErrors.ValueFloat32Pointer(),
+		ConnectionTimeout:         i.ConnectionTimeout.ValueFloat32Pointer(),
+		RequestTimeout:            i.RequestTimeout.ValueFloat32Pointer(),
+		MaxRetries:                i.MaxRetries.ValueFloat32Pointer(),
+		MaxBackOff:                i.MaxBackOff.ValueFloat32Pointer(),
+		InitialBackoff:            i.InitialBackoff.ValueFloat32Pointer(),
+		BackoffRate:               i.BackoffRate.ValueFloat32Pointer(),
+		AuthenticationTimeout:     i.AuthenticationTimeout.ValueFloat32Pointer(),
+		ReauthenticationThreshold: i.ReauthenticationThreshold.ValueFloat32Pointer(),
+		Sasl:                      i.SASL.toCribl(),
+		Tls:                       i.TLS.toCribl(),
+		KafkaSchemaRegistry:       i.SchemaRegistry.toCribl(),
+		Metadata:                  toCriblMetadata(i.Metadata),
+	}
+}
+
+func (i *InputKafka) FromCriblInputKafka(model cribl.InputKafka) {
+	i.ID = types.StringPointerValue(model.Id)
+	i.Description = types.StringPointerValue(model.Description)
+	i.Disabled = types.BoolPointerValue(model.Disabled)
+	i.Environment = types.StringPointerValue(model.Environment)
+	i.Pipeline = types.StringPointerValue(model.Pipeline)
+	i.StreamTags = fromStringSlice(model.Streamtags)
+	i.SendToRoutes = types.BoolPointerValue((*bool)(model.SendToRoutes))
+	i.Connections = fromCriblConnections(model.Connections)
+	i.PQEnabled = types.BoolPointerValue((*bool)(model.PqEnabled))
+	i.PQ = fromCriblPQ(model.Pq)
+	i.Brokers = fromStringSlice(&model.Brokers)
+	i.Topics = fromStringSlice(&model.Topics)
+	i.GroupID = types.StringPointerValue(model.GroupId)
+	i.FromBeginning = types.BoolPointerValue(model.FromBeginning)
+	i.SessionTimeout = types.Float32PointerValue(model.SessionTimeout)
+	i.RebalanceTimeout = types.Float32PointerValue(model.RebalanceTimeout)
+	i.HeartbeatInterval = types.Float32PointerValue(model.HeartbeatInterval)
+	i.AutoCommitInterval = types.Float32PointerValue(model.AutoCommitInterval)
+	i.AutoCommitThreshold = types.Float32PointerValue(model.AutoCommitThreshold)
+	i.MaxBytesPerPartition = types.Float32PointerValue(model.MaxBytesPerPartition)
+	i.MaxBytes = types.Float32PointerValue(model.MaxBytes)
+	i.MaxSocketErrors = types.Float32PointerValue(model.MaxSocketErrors)
+	i.ConnectionTimeout = types.Float32PointerValue(model.ConnectionTimeout)
+	i.RequestTimeout = types.Float32PointerValue(model.RequestTimeout)
+	i.MaxRetries = types.Float32PointerValue(model.MaxRetries)
+	i.MaxBackOff = types.Float32PointerValue(model.MaxBackOff)
+	i.InitialBackoff = types.Float32PointerValue(model.InitialBackoff)
+	i.BackoffRate = types.Float32PointerValue(model.BackoffRate)
+	i.AuthenticationTimeout = types.Float32PointerValue(model.AuthenticationTimeout)
+	i.ReauthenticationThreshold = types.Float32PointerValue(model.ReauthenticationThreshold)
+	i.SASL = fromCriblKafkaSASL(model.Sasl)
+	i.TLS = fromCriblOutputTLS(model.Tls)
+	i.SchemaRegistry = fromCriblKafkaSchemaRegistry(model.KafkaSchemaRegistry)
+	i.Metadata = fromCriblMetadata(model.Metadata)
+}
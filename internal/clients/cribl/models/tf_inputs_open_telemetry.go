@@ -0,0 +1,173 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type InputOpenTelemetry struct {
+	ID             types.String  `tfsdk:"id"`
+	Description    types.String  `tfsdk:"description"`
+	Disabled       types.Bool    `tfsdk:"disabled"`
+	Environment    types.String  `tfsdk:"environment"`
+	Pipeline       types.String  `tfsdk:"pipeline"`
+	StreamTags     types.List    `tfsdk:"stream_tags"`
+	SendToRoutes   types.Bool    `tfsdk:"send_to_routes"`
+	Connections    []Connection  `tfsdk:"connections"`
+	PQEnabled      types.Bool    `tfsdk:"pq_enabled"`
+	PQ             *InputPQ      `tfsdk:"pq"`
+	Host           types.String  `tfsdk:"host"`
+	Port           types.Float32 `tfsdk:"port"`
+	Protocol       types.String  `tfsdk:"protocol"`
+	OtlpVersion    types.String  `tfsdk:"otlp_version"`
+	ExtractSpans   types.Bool    `tfsdk:"extract_spans"`
+	ExtractMetrics types.Bool    `tfsdk:"extract_metrics"`
+	ExtractLogs    types.Bool    `tfsdk:"extract_logs"`
+	TLS            *InputTLS     `tfsdk:"tls"`
+	HTTPAuth
+	MaxActiveReq          types.Float32   `tfsdk:"max_active_req"`
+	MaxRequestsPerSocket  types.Int64     `tfsdk:"max_requests_per_socket"`
+	MaxActiveCxn          types.Float32   `tfsdk:"max_active_cxn"`
+	EnableProxyHeader     types.Bool      `tfsdk:"enable_proxy_header"`
+	CaptureHeaders        types.Bool      `tfsdk:"capture_headers"`
+	ActivityLogSampleRate types.Float32   `tfsdk:"activity_log_sample_rate"`
+	RequestTimeout        types.Float32   `tfsdk:"request_timeout"`
+	SocketTimeout         types.Float32   `tfsdk:"socket_timeout"`
+	KeepAliveTimeout      types.Float32   `tfsdk:"keep_alive_timeout"`
+	EnableHealthCheck     types.Bool      `tfsdk:"enable_health_check"`
+	IPAllowlistRegex      types.String    `tfsdk:"ip_allowlist_regex"`
+	IPDenylistRegex       types.String    `tfsdk:"ip_denylist_regex"`
+	Metadata              []MetadataField `tfsdk:"metadata"`
+}
+
+func (i *InputOpenTelemetry) ToCriblInputOpenTelemetry() cribl.InputOpenTelemetry {
+	out := cribl.InputOpenTelemetry{
+		Id:                 i.ID.ValueStringPointer(),
+		Type:               lo.ToPtr(cribl.InputOpenTelemetryType("open_telemetry")),
+		Description:        i.Description.ValueStringPointer(),
+		Disabled:           i.Disabled.ValueBoolPointer(),
+		Environment:        i.Environment.ValueStringPointer(),
+		Pipeline:           i.Pipeline.ValueStringPointer(),
+		Streamtags:         toStringSlice(i.StreamTags),
+		SendToRoutes:       (*cribl.InputOpenTelemetrySendToRoutes)(i.SendToRoutes.ValueBoolPointer()),
+		Connections:        toCriblConnections(i.Connections),
+		PqEnabled:          (*cribl.InputOpenTelemetryPqEnabled)(i.PQEnabled.ValueBoolPointer()),
+		Pq:                 i.PQ.toCribl(),
+		Host:               i.Host.ValueString(),
+		Port:               i.Port.ValueFloat32(),
+		Protocol:           (*cribl.InputOpenTelemetryProtocol)(i.Protocol.ValueStringPointer()),
+		OtlpVersion:        (*cribl.InputOpenTelemetryOtlpVersion)(i.OtlpVersion.ValueStringPointer()),
+		ExtractSpans:       i.ExtractSpans.ValueBoolPointer(),
+		ExtractMetrics:     i.ExtractMetrics.ValueBoolPointer(),
+		ExtractLogs:        i.ExtractLogs.ValueBoolPointer(),
+		Tls:                i.TLS.toCribl(),
+		AuthType:           (*cribl.InputOpenTelemetryAuthType)(i.AuthType.ValueStringPointer()),
+		Username:           i.Username.ValueStringPointer(),
+		Password:           i.Password.ValueStringPointer(),
+		Token:              i.Token.ValueStringPointer(),
+		CredentialsSecret:  i.CredentialsSecret.ValueStringPointer(),
+		TextSecret:         i.TextSecret.ValueStringPointer(),
+		LoginUrl:           i.LoginURL.ValueStringPointer(),
+		SecretParamName:    i.SecretParamName.ValueStringPointer(),
+		Secret:             i.Secret.ValueStringPointer(),
+		TokenAttributeName: i.TokenAttributeName.ValueStringPointer(),
+		AuthHeaderExpr:     i.AuthHeaderExpr.ValueStringPointer(),
+		TokenTimeoutSecs:   i.TokenTimeoutSecs.ValueFloat32Pointer(),
+		OauthParams:        toCriblOauthParams(i.OauthParams),
+		OauthHeaders:       toCriblOauthHeaders(i.OauthHeaders),
+		MaxActiveReq:       i.MaxActiveReq.ValueFloat32Pointer(),
+		MaxActiveCxn:       i.MaxActiveCxn.ValueFloat32Pointer(),
+		RequestTimeout:     i.RequestTimeout.ValueFloat32Pointer(),
+		SocketTimeout:      i.SocketTimeout.ValueFloat32Pointer(),
+		KeepAliveTimeout:   i.KeepAliveTimeout.ValueFloat32Pointer(),
+		EnableHealthCheck:  i.EnableHealthCheck.ValueBoolPointer(),
+		IpAllowlistRegex:   i.IPAllowlistRegex.ValueStringPointer(),
+		IpDenylistRegex:    i.IPDenylistRegex.ValueStringPointer(),
+		Metadata:           toCriblMetadata(i.Metadata),
+	}
+	if !i.MaxRequestsPerSocket.IsNull() {
+		out.MaxRequestsPerSocket = lo.ToPtr(int(i.MaxRequestsPerSocket.ValueInt64()))
+	}
+	if !i.EnableProxyHeader.IsNull() {
+		var v interface{} = i.EnableProxyHeader.ValueBool()
+		out.EnableProxyHeader = &v
+	}
+	if !i.CaptureHeaders.IsNull() {
+		var v interface{} = i.CaptureHeaders.ValueBool()
+		out.CaptureHeaders = &v
+	}
+	if !i.ActivityLogSampleRate.IsNull() {
+		var v interface{} = i.ActivityLogSampleRate.ValueFloat32()
+		out.ActivityLogSampleRate = &v
+	}
+	return out
+}
+
+func (i *InputOpenTelemetry) FromCriblInputOpenTelemetry(model cribl.InputOpenTelemetry) {
+	i.ID = types.StringPointerValue(model.Id)
+	i.Description = types.StringPointerValue(model.Description)
+	i.Disabled = types.BoolPointerValue(model.Disabled)
+	i.Environment = types.StringPointerValue(model.Environment)
+	i.Pipeline = types.StringPointerValue(model.Pipeline)
+	i.StreamTags = fromStringSlice(model.Streamtags)
+	i.SendToRoutes = types.BoolPointerValue((*bool)(model.SendToRoutes))
+	i.Connections = fromCriblConnections(model.Connections)
+	i.PQEnabled = types.BoolPointerValue((*bool)(model.PqEnabled))
+	i.PQ = fromCriblPQ(model.Pq)
+	i.Host = types.StringValue(model.Host)
+	i.Port = types.Float32Value(model.Port)
+	i.Protocol = types.StringPointerValue((*string)(model.Protocol))
+	i.OtlpVersion = types.StringPointerValue((*string)(model.OtlpVersion))
+	i.ExtractSpans = types.BoolPointerValue(model.ExtractSpans)
+	i.ExtractMetrics = types.BoolPointerValue(model.ExtractMetrics)
+	i.ExtractLogs = types.BoolPointerValue(model.ExtractLogs)
+	i.TLS = fromCriblTLS(model.Tls)
+	i.AuthType = types.StringPointerValue((*string)(model.AuthType))
+	i.Username = types.StringPointerValue(model.Username)
+	i.Password = types.StringPointerValue(model.Password)
+	i.Token = types.StringPointerValue(model.Token)
+	i.CredentialsSecret = types.StringPointerValue(model.CredentialsSecret)
+	i.TextSecret = types.StringPointerValue(model.TextSecret)
+	i.LoginURL = types.StringPointerValue(model.LoginUrl)
+	i.SecretParamName = types.StringPointerValue(model.SecretParamName)
+	i.Secret = types.StringPointerValue(model.Secret)
+	i.TokenAttributeName = types.StringPointerValue(model.TokenAttributeName)
+	i.AuthHeaderExpr = types.StringPointerValue(model.AuthHeaderExpr)
+	i.TokenTimeoutSecs = types.Float32PointerValue(model.TokenTimeoutSecs)
+	i.OauthParams = fromCriblOauthParams(model.OauthParams)
+	i.OauthHeaders = fromCriblOauthHeaders(model.OauthHeaders)
+	i.MaxActiveReq = types.Float32PointerValue(model.MaxActiveReq)
+	i.MaxRequestsPerSocket = types.Int64Null()
+	if model.MaxRequestsPerSocket != nil {
+		i.MaxRequestsPerSocket = types.Int64Value(int64(*model.MaxRequestsPerSocket))
+	}
+	i.MaxActiveCxn = types.Float32PointerValue(model.MaxActiveCxn)
+	i.RequestTimeout = types.Float32PointerValue(model.RequestTimeout)
+	i.SocketTimeout = types.Float32PointerValue(model.SocketTimeout)
+	i.KeepAliveTimeout = types.Float32PointerValue(model.KeepAliveTimeout)
+	i.EnableHealthCheck = types.BoolPointerValue(model.EnableHealthCheck)
+	i.IPAllowlistRegex = types.StringPointerValue(model.IpAllowlistRegex)
+	i.IPDenylistRegex = types.StringPointerValue(model.IpDenylistRegex)
+	i.Metadata = fromCriblMetadata(model.Metadata)
+
+	// free-form in the api, cribl stores bools and a number here
+	i.EnableProxyHeader = types.BoolNull()
+	if model.EnableProxyHeader != nil {
+		if v, ok := (*model.EnableProxyHeader).(bool); ok {
+			i.EnableProxyHeader = types.BoolValue(v)
+		}
+	}
+	i.CaptureHeaders = types.BoolNull()
+	if model.CaptureHeaders != nil {
+		if v, ok := (*model.CaptureHeaders).(bool); ok {
+			i.CaptureHeaders = types.BoolValue(v)
+		}
+	}
+	i.ActivityLogSampleRate = types.Float32Null()
+	if model.ActivityLogSampleRate != nil {
+		if v, ok := (*model.ActivityLogSampleRate).(float64); ok {
+			i.ActivityLogSampleRate = types.Float32Value(float32(v))
+		}
+	}
+}
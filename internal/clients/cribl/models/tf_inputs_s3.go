@@ -0,0 +1,152 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+)
+
+type InputS3 struct {
+	ID                          types.String          `tfsdk:"id"`
+	Description                 types.String          `tfsdk:"description"`
+	Disabled                    types.Bool            `tfsdk:"disabled"`
+	Environment                 types.String          `tfsdk:"environment"`
+	Pipeline                    types.String          `tfsdk:"pipeline"`
+	StreamTags                  types.List            `tfsdk:"stream_tags"`
+	SendToRoutes                types.Bool            `tfsdk:"send_to_routes"`
+	Connections                 []Connection          `tfsdk:"connections"`
+	PQEnabled                   types.Bool            `tfsdk:"pq_enabled"`
+	PQ                          *InputPQ              `tfsdk:"pq"`
+	QueueName                   types.String          `tfsdk:"queue_name"`
+	AwsAccountID                types.String          `tfsdk:"aws_account_id"`
+	Region                      types.String          `tfsdk:"region"`
+	Endpoint                    types.String          `tfsdk:"endpoint"`
+	SignatureVersion            types.String          `tfsdk:"signature_version"`
+	ReuseConnections            types.Bool            `tfsdk:"reuse_connections"`
+	RejectUnauthorized          types.Bool            `tfsdk:"reject_unauthorized"`
+	EnableSQSAssumeRole         types.Bool            `tfsdk:"enable_sqs_assume_role"`
+	FileFilter                  types.String          `tfsdk:"file_filter"`
+	BreakerRulesets             types.List            `tfsdk:"breaker_rulesets"`
+	StaleChannelFlushMs         types.Float32         `tfsdk:"stale_channel_flush_ms"`
+	Encoding                    types.String          `tfsdk:"encoding"`
+	Preprocess                  *InputPreprocess      `tfsdk:"preprocess"`
+	Checkpointing               *InputS3Checkpointing `tfsdk:"checkpointing"`
+	SkipOnError                 types.Bool            `tfsdk:"skip_on_error"`
+	MaxMessages                 types.Float32         `tfsdk:"max_messages"`
+	VisibilityTimeout           types.Float32         `tfsdk:"visibility_timeout"`
+	NumReceivers                types.Float32         `tfsdk:"num_receivers"`
+	PollTimeout                 types.Float32         `tfsdk:"poll_timeout"`
+	SocketTimeout               types.Float32         `tfsdk:"socket_timeout"`
+	ParquetChunkSizeMB          types.Float32         `tfsdk:"parquet_chunk_size_mb"`
+	ParquetChunkDownloadTimeout types.Float32         `tfsdk:"parquet_chunk_download_timeout"`
+	Metadata                    []MetadataField       `tfsdk:"metadata"`
+	AWSAuth
+}
+
+// InputS3Checkpointing enables resuming files after an interruption when set.
+type InputS3Checkpointing struct {
+	Retries types.Float32 `tfsdk:"retries"`
+}
+
+func (i *InputS3) ToCriblInputS3() cribl.InputS3 {
+	aws := i.AWSAuth.toCribl()
+	out := cribl.InputS3{
+		Id:                          i.ID.ValueStringPointer(),
+		Type:                        cribl.InputS3Type("s3"),
+		Description:                 i.Description.ValueStringPointer(),
+		Disabled:                    i.Disabled.ValueBoolPointer(),
+		Environment:                 i.Environment.ValueStringPointer(),
+		Pipeline:                    i.Pipeline.ValueStringPointer(),
+		Streamtags:                  toStringSlice(i.StreamTags),
+		SendToRoutes:                (*cribl.InputS3SendToRoutes)(i.SendToRoutes.ValueBoolPointer()),
+		Connections:                 toCriblConnections(i.Connections),
+		PqEnabled:                   (*cribl.InputS3PqEnabled)(i.PQEnabled.ValueBoolPointer()),
+		Pq:                          i.PQ.toCribl(),
+		QueueName:                   i.QueueName.ValueString(),
+		AwsAccountId:                i.AwsAccountID.ValueStringPointer(),
+		Region:                      i.Region.ValueStringPointer(),
+		Endpoint:                    i.Endpoint.ValueStringPointer(),
+		SignatureVersion:            (*cribl.InputS3SignatureVersion)(i.SignatureVersion.ValueStringPointer()),
+		ReuseConnections:            i.ReuseConnections.ValueBoolPointer(),
+		RejectUnauthorized:          i.RejectUnauthorized.ValueBoolPointer(),
+		EnableSQSAssumeRole:         i.EnableSQSAssumeRole.ValueBoolPointer(),
+		FileFilter:                  i.FileFilter.ValueStringPointer(),
+		BreakerRulesets:             toStringSlice(i.BreakerRulesets),
+		StaleChannelFlushMs:         i.StaleChannelFlushMs.ValueFloat32Pointer(),
+		Encoding:                    i.Encoding.ValueStringPointer(),
+		Preprocess:                  i.Preprocess.toCribl(),
+		SkipOnError:                 i.SkipOnError.ValueBoolPointer(),
+		MaxMessages:                 i.MaxMessages.ValueFloat32Pointer(),
+		VisibilityTimeout:           i.VisibilityTimeout.ValueFloat32Pointer(),
+		NumReceivers:                i.NumReceivers.ValueFloat32Pointer(),
+		PollTimeout:                 i.PollTimeout.ValueFloat32Pointer(),
+		SocketTimeout:               i.SocketTimeout.ValueFloat32Pointer(),
+		ParquetChunkSizeMB:          i.ParquetChunkSizeMB.ValueFloat32Pointer(),
+		ParquetChunkDownloadTimeout: i.ParquetChunkDownloadTimeout.ValueFloat32Pointer(),
+		Metadata:                    toCriblMetadata(i.Metadata),
+		AwsAuthenticationMethod:     (*cribl.InputS3AwsAuthenticationMethod)(aws.AuthenticationMethod),
+		AwsApiKey:                   aws.ApiKey,
+		AwsSecretKey:                aws.SecretKey,
+		AwsSecret:                   aws.Secret,
+		EnableAssumeRole:            aws.EnableAssumeRole,
+		AssumeRoleArn:               aws.AssumeRoleArn,
+		AssumeRoleExternalId:        aws.AssumeRoleExternalId,
+		DurationSeconds:             aws.DurationSeconds,
+	}
+	if i.Checkpointing != nil {
+		out.Checkpointing = &cribl.InputS3Checkpointing{
+			Enabled: true,
+			Retries: i.Checkpointing.Retries.ValueFloat32Pointer(),
+		}
+	}
+	return out
+}
+
+func (i *InputS3) FromCriblInputS3(model cribl.InputS3) {
+	i.ID = types.StringPointerValue(model.Id)
+	i.Description = types.StringPointerValue(model.Description)
+	i.Disabled = types.BoolPointerValue(model.Disabled)
+	i.Environment = types.StringPointerValue(model.Environment)
+	i.Pipeline = types.StringPointerValue(model.Pipeline)
+	i.StreamTags = fromStringSlice(model.Streamtags)
+	i.SendToRoutes = types.BoolPointerValue((*bool)(model.SendToRoutes))
+	i.Connections = fromCriblConnections(model.Connections)
+	i.PQEnabled = types.BoolPointerValue((*bool)(model.PqEnabled))
+	i.PQ = fromCriblPQ(model.Pq)
+	i.QueueName = types.StringValue(model.QueueName)
+	i.AwsAccountID = types.StringPointerValue(model.AwsAccountId)
+	i.Region = types.StringPointerValue(model.Region)
+	i.Endpoint = types.StringPointerValue(model.Endpoint)
+	i.SignatureVersion = types.StringPointerValue((*string)(model.SignatureVersion))
+	i.ReuseConnections = types.BoolPointerValue(model.ReuseConnections)
+	i.RejectUnauthorized = types.BoolPointerValue(model.RejectUnauthorized)
+	i.EnableSQSAssumeRole = types.BoolPointerValue(model.EnableSQSAssumeRole)
+	i.FileFilter = types.StringPointerValue(model.FileFilter)
+	i.BreakerRulesets = fromStringSlice(model.BreakerRulesets)
+	i.StaleChannelFlushMs = types.Float32PointerValue(model.StaleChannelFlushMs)
+	i.Encoding = types.StringPointerValue(model.Encoding)
+	i.Preprocess = fromCriblPreprocess(model.Preprocess)
+	i.SkipOnError = types.BoolPointerValue(model.SkipOnError)
+	i.MaxMessages = types.Float32PointerValue(model.MaxMessages)
+	i.VisibilityTimeout = types.Float32PointerValue(model.VisibilityTimeout)
+	i.NumReceivers = types.Float32PointerValue(model.NumReceivers)
+	i.PollTimeout = types.Float32PointerValue(model.PollTimeout)
+	i.SocketTimeout = types.Float32PointerValue(model.SocketTimeout)
+	i.ParquetChunkSizeMB = types.Float32PointerValue(model.ParquetChunkSizeMB)
+	i.ParquetChunkDownloadTimeout = types.Float32PointerValue(model.ParquetChunkDownloadTimeout)
+	i.Metadata = fromCriblMetadata(model.Metadata)
+	i.AWSAuth.fromCribl(criblAWSAuth{
+		AuthenticationMethod: (*string)(model.AwsAuthenticationMethod),
+		Secret:               model.AwsSecret,
+		EnableAssumeRole:     model.EnableAssumeRole,
+		AssumeRoleArn:        model.AssumeRoleArn,
+		AssumeRoleExternalId: model.AssumeRoleExternalId,
+		DurationSeconds:      model.DurationSeconds,
+	})
+
+	i.Checkpointing = nil
+	if model.Checkpointing != nil && model.Checkpointing.Enabled {
+		i.Checkpointing = &InputS3Checkpointing{
+			Retries: types.Float32PointerValue(model.Checkpointing.Retries),
+		}
+	}
+}
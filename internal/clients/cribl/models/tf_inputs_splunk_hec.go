@@ -0,0 +1,232 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type InputSplunkHec struct {
+	ID                        types.String         `tfsdk:"id"`
+	Description               types.String         `tfsdk:"description"`
+	Disabled                  types.Bool           `tfsdk:"disabled"`
+	Environment               types.String         `tfsdk:"environment"`
+	Pipeline                  types.String         `tfsdk:"pipeline"`
+	StreamTags                types.List           `tfsdk:"stream_tags"`
+	SendToRoutes              types.Bool           `tfsdk:"send_to_routes"`
+	Connections               []Connection         `tfsdk:"connections"`
+	PQEnabled                 types.Bool           `tfsdk:"pq_enabled"`
+	PQ                        *InputPQ             `tfsdk:"pq"`
+	Host                      types.String         `tfsdk:"host"`
+	Port                      types.Float32        `tfsdk:"port"`
+	AuthTokens                []SplunkHecAuthToken `tfsdk:"auth_tokens"`
+	TLS                       *InputTLS            `tfsdk:"tls"`
+	MaxActiveReq              types.Float32        `tfsdk:"max_active_req"`
+	MaxRequestsPerSocket      types.Int64          `tfsdk:"max_requests_per_socket"`
+	EnableProxyHeader         types.Bool           `tfsdk:"enable_proxy_header"`
+	CaptureHeaders            types.Bool           `tfsdk:"capture_headers"`
+	ActivityLogSampleRate     types.Float32        `tfsdk:"activity_log_sample_rate"`
+	RequestTimeout            types.Float32        `tfsdk:"request_timeout"`
+	SocketTimeout             types.Float32        `tfsdk:"socket_timeout"`
+	KeepAliveTimeout          types.Float32        `tfsdk:"keep_alive_timeout"`
+	EnableHealthCheck         types.Bool           `tfsdk:"enable_health_check"`
+	IPAllowlistRegex          types.String         `tfsdk:"ip_allowlist_regex"`
+	IPDenylistRegex           types.String         `tfsdk:"ip_denylist_regex"`
+	SplunkHecAPI              types.String         `tfsdk:"splunk_hec_api"`
+	SplunkHecAcks             types.Bool           `tfsdk:"splunk_hec_acks"`
+	AllowedIndexes            types.List           `tfsdk:"allowed_indexes"`
+	BreakerRulesets           types.List           `tfsdk:"breaker_rulesets"`
+	StaleChannelFlushMs       types.Float32        `tfsdk:"stale_channel_flush_ms"`
+	UseFwdTimezone            types.Bool           `tfsdk:"use_fwd_timezone"`
+	DropControlFields         types.Bool           `tfsdk:"drop_control_fields"`
+	ExtractMetrics            types.Bool           `tfsdk:"extract_metrics"`
+	EmitTokenMetrics          types.Bool           `tfsdk:"emit_token_metrics"`
+	AccessControlAllowOrigin  types.List           `tfsdk:"access_control_allow_origin"`
+	AccessControlAllowHeaders types.List           `tfsdk:"access_control_allow_headers"`
+	Metadata                  []MetadataField      `tfsdk:"metadata"`
+}
+
+type SplunkHecAuthToken struct {
+	AuthType       types.String    `tfsdk:"auth_type"`
+	Token          types.String    `tfsdk:"token"`
+	TokenSecret    types.String    `tfsdk:"token_secret"`
+	Description    types.String    `tfsdk:"description"`
+	Enabled        types.Bool      `tfsdk:"enabled"`
+	AllowedIndexes types.List      `tfsdk:"allowed_indexes"`
+	Metadata       []MetadataField `tfsdk:"metadata"`
+}
+
+func (i *InputSplunkHec) ToCriblInputSplunkHec() cribl.InputSplunkHec {
+	out := cribl.InputSplunkHec{
+		Id:                        i.ID.ValueStringPointer(),
+		Type:                      lo.ToPtr(cribl.InputSplunkHecType("splunk_hec")),
+		Description:               i.Description.ValueStringPointer(),
+		Disabled:                  i.Disabled.ValueBoolPointer(),
+		Environment:               i.Environment.ValueStringPointer(),
+		Pipeline:                  i.Pipeline.ValueStringPointer(),
+		Streamtags:                toStringSlice(i.StreamTags),
+		SendToRoutes:              (*cribl.InputSplunkHecSendToRoutes)(i.SendToRoutes.ValueBoolPointer()),
+		Connections:               toCriblConnections(i.Connections),
+		PqEnabled:                 (*cribl.InputSplunkHecPqEnabled)(i.PQEnabled.ValueBoolPointer()),
+		Pq:                        i.PQ.toCribl(),
+		Host:                      i.Host.ValueString(),
+		Port:                      i.Port.ValueFloat32(),
+		Tls:                       i.TLS.toCribl(),
+		MaxActiveReq:              i.MaxActiveReq.ValueFloat32Pointer(),
+		EnableProxyHeader:         i.EnableProxyHeader.ValueBoolPointer(),
+		CaptureHeaders:            i.CaptureHeaders.ValueBoolPointer(),
+		ActivityLogSampleRate:     i.ActivityLogSampleRate.ValueFloat32Pointer(),
+		RequestTimeout:            i.RequestTimeout.ValueFloat32Pointer(),
+		SocketTimeout:             i.SocketTimeout.ValueFloat32Pointer(),
+		KeepAliveTimeout:          i.KeepAliveTimeout.ValueFloat32Pointer(),
+		IpAllowlistRegex:          i.IPAllowlistRegex.ValueStringPointer(),
+		IpDenylistRegex:           i.IPDenylistRegex.ValueStringPointer(),
+		SplunkHecAPI:              i.SplunkHecAPI.ValueString(),
+		SplunkHecAcks:             i.SplunkHecAcks.ValueBoolPointer(),
+		AllowedIndexes:            toStringSlice(i.AllowedIndexes),
+		BreakerRulesets:           toStringSlice(i.BreakerRulesets),
+		StaleChannelFlushMs:       i.StaleChannelFlushMs.ValueFloat32Pointer(),
+		UseFwdTimezone:            i.UseFwdTimezone.ValueBoolPointer(),
+		DropControlFields:         i.DropControlFields.ValueBoolPointer(),
+		ExtractMetrics:            i.ExtractMetrics.ValueBoolPointer(),
+		EmitTokenMetrics:          i.EmitTokenMetrics.ValueBoolPointer(),
+		AccessControlAllowOrigin:  toStringSlice(i.AccessControlAllowOrigin),
+		AccessControlAllowHeaders: toStringSlice(i.AccessControlAllowHeaders),
+		Metadata:                  toCriblMetadata(i.Metadata),
+	}
+	if !i.MaxRequestsPerSocket.IsNull() {
+		out.MaxRequestsPerSocket = lo.ToPtr(int(i.MaxRequestsPerSocket.ValueInt64()))
+	}
+	if !i.EnableHealthCheck.IsNull() {
+		var v interface{} = i.EnableHealthCheck.ValueBool()
+		out.EnableHealthCheck = &v
+	}
+	if i.AuthTokens != nil {
+		tokens := []cribl.InputSplunkHecAuthToken{}
+		for _, token := range i.AuthTokens {
+			tokens = append(tokens, token.toCribl())
+		}
+		out.AuthTokens = &tokens
+	}
+	return out
+}
+
+func (i *InputSplunkHec) FromCriblInputSplunkHec(model cribl.InputSplunkHec) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	i.ID = types.StringPointerValue(model.Id)
+	i.Description = types.StringPointerValue(model.Description)
+	i.Disabled = types.BoolPointerValue(model.Disabled)
+	i.Environment = types.StringPointerValue(model.Environment)
+	i.Pipeline = types.StringPointerValue(model.Pipeline)
+	i.StreamTags = fromStringSlice(model.Streamtags)
+	i.SendToRoutes = types.BoolPointerValue((*bool)(model.SendToRoutes))
+	i.Connections = fromCriblConnections(model.Connections)
+	i.PQEnabled = types.BoolPointerValue((*bool)(model.PqEnabled))
+	i.PQ = fromCriblPQ(model.Pq)
+	i.Host = types.StringValue(model.Host)
+	i.Port = types.Float32Value(model.Port)
+	i.TLS = fromCriblTLS(model.Tls)
+	i.MaxActiveReq = types.Float32PointerValue(model.MaxActiveReq)
+	i.MaxRequestsPerSocket = types.Int64Null()
+	if model.MaxRequestsPerSocket != nil {
+		i.MaxRequestsPerSocket = types.Int64Value(int64(*model.MaxRequestsPerSocket))
+	}
+	i.EnableProxyHeader = types.BoolPointerValue(model.EnableProxyHeader)
+	i.CaptureHeaders = types.BoolPointerValue(model.CaptureHeaders)
+	i.ActivityLogSampleRate = types.Float32PointerValue(model.ActivityLogSampleRate)
+	i.RequestTimeout = types.Float32PointerValue(model.RequestTimeout)
+	i.SocketTimeout = types.Float32PointerValue(model.SocketTimeout)
+	i.KeepAliveTimeout = types.Float32PointerValue(model.KeepAliveTimeout)
+	i.EnableHealthCheck = types.BoolNull()
+	if model.EnableHealthCheck != nil {
+		if v, ok := (*model.EnableHealthCheck).(bool); ok {
+			i.EnableHealthCheck = types.BoolValue(v)
+		}
+	}
+	i.IPAllowlistRegex = types.StringPointerValue(model.IpAllowlistRegex)
+	i.IPDenylistRegex = types.StringPointerValue(model.IpDenylistRegex)
+	i.SplunkHecAPI = types.StringValue(model.SplunkHecAPI)
+	i.SplunkHecAcks = types.BoolPointerValue(model.SplunkHecAcks)
+	i.AllowedIndexes = fromStringSlice(model.AllowedIndexes)
+	i.BreakerRulesets = fromStringSlice(model.BreakerRulesets)
+	i.StaleChannelFlushMs = types.Float32PointerValue(model.StaleChannelFlushMs)
+	i.UseFwdTimezone = types.BoolPointerValue(model.UseFwdTimezone)
+	i.DropControlFields = types.BoolPointerValue(model.DropControlFields)
+	i.ExtractMetrics = types.BoolPointerValue(model.ExtractMetrics)
+	i.EmitTokenMetrics = types.BoolPointerValue(model.EmitTokenMetrics)
+	i.AccessControlAllowOrigin = fromStringSlice(model.AccessControlAllowOrigin)
+	i.AccessControlAllowHeaders = fromStringSlice(model.AccessControlAllowHeaders)
+	i.Metadata = fromCriblMetadata(model.Metadata)
+
+	// tokens are read back as stored so a token rotated or removed outside
+	// of terraform shows up as a change
+	i.AuthTokens = nil
+	if model.AuthTokens != nil {
+		i.AuthTokens = []SplunkHecAuthToken{}
+	}
+	for n, token := range lo.FromPtr(model.AuthTokens) {
+		out, d := fromCriblSplunkHecAuthToken(path.Root("auth_tokens").AtListIndex(n), token)
+		diags.Append(d...)
+		i.AuthTokens = append(i.AuthTokens, out)
+	}
+	return diags
+}
+
+func (t *SplunkHecAuthToken) toCribl() cribl.InputSplunkHecAuthToken {
+	out := cribl.InputSplunkHecAuthToken{
+		AuthType:              (*cribl.InputSplunkHecAuthTokensAuthType)(t.AuthType.ValueStringPointer()),
+		Description:           t.Description.ValueStringPointer(),
+		Enabled:               t.Enabled.ValueBoolPointer(),
+		AllowedIndexesAtToken: toStringSlice(t.AllowedIndexes),
+		Metadata:              toCriblMetadata(t.Metadata),
+	}
+	if !t.Token.IsNull() {
+		out.Token = t.Token.ValueString()
+	}
+	if !t.TokenSecret.IsNull() {
+		var v interface{} = t.TokenSecret.ValueString()
+		out.TokenSecret = &v
+	}
+	return out
+}
+
+func fromCriblSplunkHecAuthToken(tokenPath path.Path, token cribl.InputSplunkHecAuthToken) (SplunkHecAuthToken, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := SplunkHecAuthToken{
+		AuthType:       types.StringPointerValue((*string)(token.AuthType)),
+		Token:          types.StringNull(),
+		TokenSecret:    types.StringNull(),
+		Description:    types.StringPointerValue(token.Description),
+		Enabled:        types.BoolPointerValue(token.Enabled),
+		AllowedIndexes: fromStringSlice(token.AllowedIndexesAtToken),
+		Metadata:       fromCriblMetadata(token.Metadata),
+	}
+	if token.Token != nil {
+		if v, ok := token.Token.(string); ok {
+			out.Token = types.StringValue(v)
+		} else {
+			diags.AddAttributeError(
+				tokenPath.AtName("token"),
+				"Unexpected HEC token",
+				fmt.Sprintf("Expected the token to be a string, got %T.", token.Token),
+			)
+		}
+	}
+	if token.TokenSecret != nil && *token.TokenSecret != nil {
+		if v, ok := (*token.TokenSecret).(string); ok {
+			out.TokenSecret = types.StringValue(v)
+		} else {
+			diags.AddAttributeError(
+				tokenPath.AtName("token_secret"),
+				"Unexpected HEC token secret",
+				fmt.Sprintf("Expected the token secret to be a string, got %T.", *token.TokenSecret),
+			)
+		}
+	}
+	return out, diags
+}
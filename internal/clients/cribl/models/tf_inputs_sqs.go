@@ -0,0 +1,109 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type InputSqs struct {
+	ID                 types.String    `tfsdk:"id"`
+	Description        types.String    `tfsdk:"description"`
+	Disabled           types.Bool      `tfsdk:"disabled"`
+	Environment        types.String    `tfsdk:"environment"`
+	Pipeline           types.String    `tfsdk:"pipeline"`
+	StreamTags         types.List      `tfsdk:"stream_tags"`
+	SendToRoutes       types.Bool      `tfsdk:"send_to_routes"`
+	Connections        []Connection    `tfsdk:"connections"`
+	PQEnabled          types.Bool      `tfsdk:"pq_enabled"`
+	PQ                 *InputPQ        `tfsdk:"pq"`
+	QueueName          types.String    `tfsdk:"queue_name"`
+	QueueType          types.String    `tfsdk:"queue_type"`
+	AwsAccountID       types.String    `tfsdk:"aws_account_id"`
+	CreateQueue        types.Bool      `tfsdk:"create_queue"`
+	Region             types.String    `tfsdk:"region"`
+	Endpoint           types.String    `tfsdk:"endpoint"`
+	SignatureVersion   types.String    `tfsdk:"signature_version"`
+	ReuseConnections   types.Bool      `tfsdk:"reuse_connections"`
+	RejectUnauthorized types.Bool      `tfsdk:"reject_unauthorized"`
+	MaxMessages        types.Float32   `tfsdk:"max_messages"`
+	VisibilityTimeout  types.Float32   `tfsdk:"visibility_timeout"`
+	NumReceivers       types.Float32   `tfsdk:"num_receivers"`
+	PollTimeout        types.Float32   `tfsdk:"poll_timeout"`
+	Metadata           []MetadataField `tfsdk:"metadata"`
+	AWSAuth
+}
+
+func (i *InputSqs) ToCriblInputSqs() cribl.InputSqs {
+	aws := i.AWSAuth.toCribl()
+	return cribl.InputSqs{
+		Id:                      i.ID.ValueStringPointer(),
+		Type:                    lo.ToPtr(cribl.InputSqsType("sqs")),
+		Description:             i.Description.ValueStringPointer(),
+		Disabled:                i.Disabled.ValueBoolPointer(),
+		Environment:             i.Environment.ValueStringPointer(),
+		Pipeline:                i.Pipeline.ValueStringPointer(),
+		Streamtags:              toStringSlice(i.StreamTags),
+		SendToRoutes:            (*cribl.InputSqsSendToRoutes)(i.SendToRoutes.ValueBoolPointer()),
+		Connections:             toCriblConnections(i.Connections),
+		PqEnabled:               (*cribl.InputSqsPqEnabled)(i.PQEnabled.ValueBoolPointer()),
+		Pq:                      i.PQ.toCribl(),
+		QueueName:               i.QueueName.ValueString(),
+		QueueType:               cribl.InputSqsQueueType(i.QueueType.ValueString()),
+		AwsAccountId:            i.AwsAccountID.ValueStringPointer(),
+		CreateQueue:             i.CreateQueue.ValueBoolPointer(),
+		Region:                  i.Region.ValueStringPointer(),
+		Endpoint:                i.Endpoint.ValueStringPointer(),
+		SignatureVersion:        (*cribl.InputSqsSignatureVersion)(i.SignatureVersion.ValueStringPointer()),
+		ReuseConnections:        i.ReuseConnections.ValueBoolPointer(),
+		RejectUnauthorized:      i.RejectUnauthorized.ValueBoolPointer(),
+		MaxMessages:             i.MaxMessages.ValueFloat32Pointer(),
+		VisibilityTimeout:       i.VisibilityTimeout.ValueFloat32Pointer(),
+		NumReceivers:            i.NumReceivers.ValueFloat32Pointer(),
+		PollTimeout:             i.PollTimeout.ValueFloat32Pointer(),
+		Metadata:                toCriblMetadata(i.Metadata),
+		AwsAuthenticationMethod: (*cribl.InputSqsAwsAuthenticationMethod)(aws.AuthenticationMethod),
+		AwsApiKey:               aws.ApiKey,
+		AwsSecretKey:            aws.SecretKey,
+		AwsSecret:               aws.Secret,
+		EnableAssumeRole:        aws.EnableAssumeRole,
+		AssumeRoleArn:           aws.AssumeRoleArn,
+		AssumeRoleExternalId:    aws.AssumeRoleExternalId,
+		DurationSeconds:         aws.DurationSeconds,
+	}
+}
+
+func (i *InputSqs) FromCriblInputSqs(model cribl.InputSqs) {
+	i.ID = types.StringPointerValue(model.Id)
+	i.Description = types.StringPointerValue(model.Description)
+	i.Disabled = types.BoolPointerValue(model.Disabled)
+	i.Environment = types.StringPointerValue(model.Environment)
+	i.Pipeline = types.StringPointerValue(model.Pipeline)
+	i.StreamTags = fromStringSlice(model.Streamtags)
+	i.SendToRoutes = types.BoolPointerValue((*bool)(model.SendToRoutes))
+	i.Connections = fromCriblConnections(model.Connections)
+	i.PQEnabled = types.BoolPointerValue((*bool)(model.PqEnabled))
+	i.PQ = fromCriblPQ(model.Pq)
+	i.QueueName = types.StringValue(model.QueueName)
+	i.QueueType = types.StringValue(string(model.QueueType))
+	i.AwsAccountID = types.StringPointerValue(model.AwsAccountId)
+	i.CreateQueue = types.BoolPointerValue(model.CreateQueue)
+	i.Region = types.StringPointerValue(model.Region)
+	i.Endpoint = types.StringPointerValue(model.Endpoint)
+	i.SignatureVersion = types.StringPointerValue((*string)(model.SignatureVersion))
+	i.ReuseConnections = types.BoolPointerValue(model.ReuseConnections)
+	i.RejectUnauthorized = types.BoolPointerValue(model.RejectUnauthorized)
+	i.MaxMessages = types.Float32PointerValue(model.MaxMessages)
+	i.VisibilityTimeout = types.Float32PointerValue(model.VisibilityTimeout)
+	i.NumReceivers = types.Float32PointerValue(model.NumReceivers)
+	i.PollTimeout = types.Float32PointerValue(model.PollTimeout)
+	i.Metadata = fromCriblMetadata(model.Metadata)
+	i.AWSAuth.fromCribl(criblAWSAuth{
+		AuthenticationMethod: (*string)(model.AwsAuthenticationMethod),
+		Secret:               model.AwsSecret,
+		EnableAssumeRole:     model.EnableAssumeRole,
+		AssumeRoleArn:        model.AssumeRoleArn,
+		AssumeRoleExternalId: model.AssumeRoleExternalId,
+		DurationSeconds:      model.DurationSeconds,
+	})
+}
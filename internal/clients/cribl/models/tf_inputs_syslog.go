@@ -0,0 +1,111 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+)
+
+type InputSyslog struct {
+	ID                         types.String    `tfsdk:"id"`
+	Description                types.String    `tfsdk:"description"`
+	Disabled                   types.Bool      `tfsdk:"disabled"`
+	Environment                types.String    `tfsdk:"environment"`
+	Pipeline                   types.String    `tfsdk:"pipeline"`
+	StreamTags                 types.List      `tfsdk:"stream_tags"`
+	SendToRoutes               types.Bool      `tfsdk:"send_to_routes"`
+	Connections                []Connection    `tfsdk:"connections"`
+	PQEnabled                  types.Bool      `tfsdk:"pq_enabled"`
+	PQ                         *InputPQ        `tfsdk:"pq"`
+	Host                       types.String    `tfsdk:"host"`
+	UDPPort                    types.Float32   `tfsdk:"udp_port"`
+	TCPPort                    types.Float32   `tfsdk:"tcp_port"`
+	MaxBufferSize              types.Float32   `tfsdk:"max_buffer_size"`
+	IPWhitelistRegex           types.String    `tfsdk:"ip_whitelist_regex"`
+	TimestampTimezone          types.String    `tfsdk:"timestamp_timezone"`
+	SingleMsgUDPPackets        types.Bool      `tfsdk:"single_msg_udp_packets"`
+	EnableProxyHeader          types.Bool      `tfsdk:"enable_proxy_header"`
+	KeepFieldsList             types.List      `tfsdk:"keep_fields_list"`
+	OctetCounting              types.Bool      `tfsdk:"octet_counting"`
+	InferFraming               types.Bool      `tfsdk:"infer_framing"`
+	StrictlyInferOctetCounting types.Bool      `tfsdk:"strictly_infer_octet_counting"`
+	AllowNonStandardAppName    types.Bool      `tfsdk:"allow_non_standard_app_name"`
+	MaxActiveCxn               types.Float32   `tfsdk:"max_active_cxn"`
+	SocketIdleTimeout          types.Float32   `tfsdk:"socket_idle_timeout"`
+	SocketEndingMaxWait        types.Float32   `tfsdk:"socket_ending_max_wait"`
+	SocketMaxLifespan          types.Float32   `tfsdk:"socket_max_lifespan"`
+	EnableLoadBalancing        types.Bool      `tfsdk:"enable_load_balancing"`
+	UDPSocketRxBufSize         types.Float32   `tfsdk:"udp_socket_rx_buf_size"`
+	TLS                        *InputTLS       `tfsdk:"tls"`
+	Metadata                   []MetadataField `tfsdk:"metadata"`
+}
+
+func (i *InputSyslog) ToCriblInputSyslog() cribl.InputSyslog {
+	return cribl.InputSyslog{
+		Id:                         i.ID.ValueStringPointer(),
+		Type:                       cribl.InputSyslogType("syslog"),
+		Description:                i.Description.ValueStringPointer(),
+		Disabled:                   i.Disabled.ValueBoolPointer(),
+		Environment:                i.Environment.ValueStringPointer(),
+		Pipeline:                   i.Pipeline.ValueStringPointer(),
+		Streamtags:                 toStringSlice(i.StreamTags),
+		SendToRoutes:               (*cribl.InputSyslogSendToRoutes)(i.SendToRoutes.ValueBoolPointer()),
+		Connections:                toCriblConnections(i.Connections),
+		PqEnabled:                  (*cribl.InputSyslogPqEnabled)(i.PQEnabled.ValueBoolPointer()),
+		Pq:                         i.PQ.toCribl(),
+		Host:                       i.Host.ValueString(),
+		UdpPort:                    i.UDPPort.ValueFloat32Pointer(),
+		TcpPort:                    i.TCPPort.ValueFloat32Pointer(),
+		MaxBufferSize:              i.MaxBufferSize.ValueFloat32Pointer(),
+		IpWhitelistRegex:           i.IPWhitelistRegex.ValueStringPointer(),
+		TimestampTimezone:          i.TimestampTimezone.ValueStringPointer(),
+		SingleMsgUdpPackets:        i.SingleMsgUDPPackets.ValueBoolPointer(),
+		EnableProxyHeader:          i.EnableProxyHeader.ValueBoolPointer(),
+		KeepFieldsList:             toStringSlice(i.KeepFieldsList),
+		OctetCounting:              i.OctetCounting.ValueBoolPointer(),
+		InferFraming:               i.InferFraming.ValueBoolPointer(),
+		StrictlyInferOctetCounting: i.StrictlyInferOctetCounting.ValueBoolPointer(),
+		AllowNonStandardAppName:    i.AllowNonStandardAppName.ValueBoolPointer(),
+		MaxActiveCxn:               i.MaxActiveCxn.ValueFloat32Pointer(),
+		SocketIdleTimeout:          i.SocketIdleTimeout.ValueFloat32Pointer(),
+		SocketEndingMaxWait:        i.SocketEndingMaxWait.ValueFloat32Pointer(),
+		SocketMaxLifespan:          i.SocketMaxLifespan.ValueFloat32Pointer(),
+		EnableLoadBalancing:        i.EnableLoadBalancing.ValueBoolPointer(),
+		UdpSocketRxBufSize:         i.UDPSocketRxBufSize.ValueFloat32Pointer(),
+		Tls:                        i.TLS.toCribl(),
+		Metadata:                   toCriblMetadata(i.Metadata),
+	}
+}
+
+func (i *InputSyslog) FromCriblInputSyslog(model cribl.InputSyslog) {
+	i.ID = types.StringPointerValue(model.Id)
+	i.Description = types.StringPointerValue(model.Description)
+	i.Disabled = types.BoolPointerValue(model.Disabled)
+	i.Environment = types.StringPointerValue(model.Environment)
+	i.Pipeline = types.StringPointerValue(model.Pipeline)
+	i.StreamTags = fromStringSlice(model.Streamtags)
+	i.SendToRoutes = types.BoolPointerValue((*bool)(model.SendToRoutes))
+	i.Connections = fromCriblConnections(model.Connections)
+	i.PQEnabled = types.BoolPointerValue((*bool)(model.PqEnabled))
+	i.PQ = fromCriblPQ(model.Pq)
+	i.Host = types.StringValue(model.Host)
+	i.UDPPort = types.Float32PointerValue(model.UdpPort)
+	i.TCPPort = types.Float32PointerValue(model.TcpPort)
+	i.MaxBufferSize = types.Float32PointerValue(model.MaxBufferSize)
+	i.IPWhitelistRegex = types.StringPointerValue(model.IpWhitelistRegex)
+	i.TimestampTimezone = types.StringPointerValue(model.TimestampTimezone)
+	i.SingleMsgUDPPackets = types.BoolPointerValue(model.SingleMsgUdpPackets)
+	i.EnableProxyHeader = types.BoolPointerValue(model.EnableProxyHeader)
+	i.KeepFieldsList = fromStringSlice(model.KeepFieldsList)
+	i.OctetCounting = types.BoolPointerValue(model.OctetCounting)
+	i.InferFraming = types.BoolPointerValue(model.InferFraming)
+	i.StrictlyInferOctetCounting = types.BoolPointerValue(model.StrictlyInferOctetCounting)
+	i.AllowNonStandardAppName = types.BoolPointerValue(model.AllowNonStandardAppName)
+	i.MaxActiveCxn = types.Float32PointerValue(model.MaxActiveCxn)
+	i.SocketIdleTimeout = types.Float32PointerValue(model.SocketIdleTimeout)
+	i.SocketEndingMaxWait = types.Float32PointerValue(model.SocketEndingMaxWait)
+	i.SocketMaxLifespan = types.Float32PointerValue(model.SocketMaxLifespan)
+	i.EnableLoadBalancing = types.BoolPointerValue(model.EnableLoadBalancing)
+	i.UDPSocketRxBufSize = types.Float32PointerValue(model.UdpSocketRxBufSize)
+	i.TLS = fromCriblTLS(model.Tls)
+	i.Metadata = fromCriblMetadata(model.Metadata)
+}
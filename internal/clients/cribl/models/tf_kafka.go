@@ -0,0 +1,121 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+)
+
+// KafkaSASL is shared by the kafka input and output. Cribl keeps a disabled
+// flag on the object; here the block being set is what enables it.
+type KafkaSASL struct {
+	Mechanism         types.String `tfsdk:"mechanism"`
+	AuthType          types.String `tfsdk:"auth_type"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	CredentialsSecret types.String `tfsdk:"credentials_secret"`
+}
+
+type KafkaSchemaRegistry struct {
+	URL               types.String  `tfsdk:"url"`
+	ConnectionTimeout types.Float32 `tfsdk:"connection_timeout"`
+	RequestTimeout    types.Float32 `tfsdk:"request_timeout"`
+	MaxRetries        types.Float32 `tfsdk:"max_retries"`
+	CredentialsSecret types.String  `tfsdk:"credentials_secret"`
+	TLS               *OutputTLS    `tfsdk:"tls"`
+}
+
+// OutputKafkaSchemaRegistry adds the schema ids only the output uses to
+// encode events.
+type OutputKafkaSchemaRegistry struct {
+	KafkaSchemaRegistry
+	DefaultKeySchemaID   types.Float32 `tfsdk:"default_key_schema_id"`
+	DefaultValueSchemaID types.Float32 `tfsdk:"default_value_schema_id"`
+}
+
+func (s *KafkaSASL) toCribl() *cribl.KafkaSasl {
+	if s == nil {
+		return nil
+	}
+	return &cribl.KafkaSasl{
+		Disabled:          false,
+		Mechanism:         s.Mechanism.ValueStringPointer(),
+		AuthType:          s.AuthType.ValueStringPointer(),
+		Username:          s.Username.ValueStringPointer(),
+		Password:          s.Password.ValueStringPointer(),
+		CredentialsSecret: s.CredentialsSecret.ValueStringPointer(),
+	}
+}
+
+func fromCriblKafkaSASL(sasl *cribl.KafkaSasl) *KafkaSASL {
+	if sasl == nil || sasl.Disabled {
+		return nil
+	}
+	return &KafkaSASL{
+		Mechanism:         types.StringPointerValue(sasl.Mechanism),
+		AuthType:          types.StringPointerValue(sasl.AuthType),
+		Username:          types.StringPointerValue(sasl.Username),
+		Password:          types.StringPointerValue(sasl.Password),
+		CredentialsSecret: types.StringPointerValue(sasl.CredentialsSecret),
+	}
+}
+
+func (r *KafkaSchemaRegistry) toCribl() *cribl.KafkaSchemaRegistry {
+	if r == nil {
+		return nil
+	}
+	out := &cribl.KafkaSchemaRegistry{
+		Disabled:          false,
+		SchemaRegistryURL: r.URL.ValueStringPointer(),
+		ConnectionTimeout: r.ConnectionTimeout.ValueFloat32Pointer(),
+		RequestTimeout:    r.RequestTimeout.ValueFloat32Pointer(),
+		MaxRetries:        r.MaxRetries.ValueFloat32Pointer(),
+		Tls:               r.TLS.toCribl(),
+	}
+	if !r.CredentialsSecret.IsNull() {
+		out.Auth = &cribl.KafkaSchemaRegistryAuth{
+			Disabled:          false,
+			CredentialsSecret: r.CredentialsSecret.ValueStringPointer(),
+		}
+	}
+	return out
+}
+
+func fromCriblKafkaSchemaRegistry(registry *cribl.KafkaSchemaRegistry) *KafkaSchemaRegistry {
+	if registry == nil || registry.Disabled {
+		return nil
+	}
+	out := &KafkaSchemaRegistry{
+		URL:               types.StringPointerValue(registry.SchemaRegistryURL),
+		ConnectionTimeout: types.Float32PointerValue(registry.ConnectionTimeout),
+		RequestTimeout:    types.Float32PointerValue(registry.RequestTimeout),
+		MaxRetries:        types.Float32PointerValue(registry.MaxRetries),
+		CredentialsSecret: types.StringNull(),
+		TLS:               fromCriblOutputTLS(registry.Tls),
+	}
+	if registry.Auth != nil && !registry.Auth.Disabled {
+		out.CredentialsSecret = types.StringPointerValue(registry.Auth.CredentialsSecret)
+	}
+	return out
+}
+
+func (r *OutputKafkaSchemaRegistry) toCribl() *cribl.KafkaSchemaRegistry {
+	if r == nil {
+		return nil
+	}
+	out := r.KafkaSchemaRegistry.toCribl()
+	out.DefaultKeySchemaId = r.DefaultKeySchemaID.ValueFloat32Pointer()
+	out.DefaultValueSchemaId = r.DefaultValueSchemaID.ValueFloat32Pointer()
+	return out
+}
+
+func fromCriblOutputKafkaSchemaRegistry(registry *cribl.KafkaSchemaRegistry) *OutputKafkaSchemaRegistry {
+	base := fromCriblKafkaSchemaRegistry(registry)
+	if base == nil {
+		return nil
+	}
+	return &OutputKafkaSchemaRegistry{
+		KafkaSchemaRegistry:  *base,
+		DefaultKeySchemaID:   types.Float32PointerValue(registry.DefaultKeySchemaId),
+		DefaultValueSchemaID: types.Float32PointerValue(registry.DefaultValueSchemaId),
+	}
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// the library objects (lookups, variables, regexes...) keep their tags as a
+// single comma separated string
+func toCriblTags(list types.List) *string {
+	tags := toStringSlice(list)
+	if tags == nil {
+		return nil
+	}
+	out := strings.Join(*tags, ",")
+	return &out
+}
+
+func fromCriblTags(tags *string) types.List {
+	if tags == nil || *tags == "" {
+		return types.ListNull(types.StringType)
+	}
+	out := []string{}
+	for _, tag := range strings.Split(*tags, ",") {
+		out = append(out, strings.TrimSpace(tag))
+	}
+	return fromStringSlice(&out)
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+)
+
+type Lookup struct {
+	ID          types.String  `tfsdk:"id"`
+	Description types.String  `tfsdk:"description"`
+	Tags        types.List    `tfsdk:"tags"`
+	Mode        types.String  `tfsdk:"mode"`
+	Content     types.String  `tfsdk:"content"`
+	File        types.String  `tfsdk:"file"`
+	ContentHash types.String  `tfsdk:"content_hash"`
+	Size        types.Float32 `tfsdk:"size"`
+}
+
+// ToCriblLookupFile builds the lookup request. filename is the name Cribl
+// returned for an uploaded file, it's only used when file is set, otherwise
+// content is sent inline.
+func (l *Lookup) ToCriblLookupFile(filename string) (cribl.LookupFile, error) {
+	out := cribl.LookupFile{
+		Id:          l.ID.ValueString(),
+		Description: l.Description.ValueStringPointer(),
+		Mode:        l.Mode.ValueStringPointer(),
+		Tags:        toCriblTags(l.Tags),
+	}
+	if !l.File.IsNull() {
+		return out, out.FromLookupFile0(cribl.LookupFile0{
+			FileInfo: &struct {
+				Filename string `json:"filename"`
+			}{Filename: filename},
+		})
+	}
+	return out, out.FromLookupFile1(cribl.LookupFile1{
+		Content: l.Content.ValueStringPointer(),
+	})
+}
+
+// FromCriblLookupFile reads back the lookup's settings. Cribl doesn't return
+// the file contents, a size that differs from the one in state means the
+// file was changed outside of Terraform, so the hash is cleared to force the
+// contents to be uploaded again.
+func (l *Lookup) FromCriblLookupFile(lookup cribl.LookupFile) {
+	l.ID = types.StringValue(lookup.Id)
+	l.Description = types.StringPointerValue(lookup.Description)
+	l.Tags = fromCriblTags(lookup.Tags)
+	if lookup.Mode != nil {
+		l.Mode = types.StringPointerValue(lookup.Mode)
+	}
+	if lookup.Size != nil {
+		if !l.Size.IsNull() && l.Size.ValueFloat32() != *lookup.Size {
+			l.ContentHash = types.StringNull()
+		}
+		l.Size = types.Float32PointerValue(lookup.Size)
+	}
+}
+
+// LookupContentHash returns the hex encoded sha256 of a lookup's contents.
+func LookupContentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -2,14 +2,16 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
 )
 
 type OutputS3 struct {
 	ID                            types.String  `tfsdk:"id" validate:"required"`
-	DefaultID                     types.String  `tfsdk:"default_id"`
 	Type                          types.String  `tfsdk:"type"`
 	Description                   types.String  `tfsdk:"description"`
 	Environment                   types.String  `tfsdk:"environment"`
@@ -27,7 +29,6 @@ type OutputS3 struct {
 	BaseFileName                  types.String  `tfsdk:"base_file_name"`
 	FileNameSuffix                types.String  `tfsdk:"file_name_suffix"`
 	PartitionExpr                 types.String  `tfsdk:"partition_expr"`
-	PartitioningFields            types.List    `tfsdk:"partitioning_fields"`
 	Format                        types.String  `tfsdk:"format"`
 	Compress                      types.String  `tfsdk:"compress"`
 	CompressionLevel              types.String  `tfsdk:"compression_level"`
@@ -42,12 +43,6 @@ type OutputS3 struct {
 	OnBackpressure                types.String  `tfsdk:"on_backpressure"`
 	OnDiskFullBackpressure        types.String  `tfsdk:"on_disk_full_backpressure"`
 	WriteHighWaterMark            types.Float32 `tfsdk:"write_high_water_mark"`
-	AwsAuthenticationMethod       types.String  `tfsdk:"aws_authentication_method"`
-	AwsApiKey                     types.String  `tfsdk:"aws_api_key"`
-	AwsSecretKey                  types.String  `tfsdk:"aws_secret_key"`
-	AwsSecret                     types.String  `tfsdk:"aws_secret"`
-	AssumeRoleArn                 types.String  `tfsdk:"assume_role_arn"`
-	AssumeRoleExternalId          types.String  `tfsdk:"assume_role_external_id"`
 	SignatureVersion              types.String  `tfsdk:"signature_version"`
 	ReuseConnections              types.Bool    `tfsdk:"reuse_connections"`
 	RejectUnauthorized            types.Bool    `tfsdk:"reject_unauthorized"`
@@ -57,8 +52,6 @@ type OutputS3 struct {
 	KmsKeyId                      types.String  `tfsdk:"kms_key_id"`
 	Endpoint                      types.String  `tfsdk:"endpoint"`
 	VerifyPermissions             types.Bool    `tfsdk:"verify_permissions"`
-	DurationSeconds               types.Float32 `tfsdk:"duration_seconds"`
-	EnableAssumeRole              types.Bool    `tfsdk:"enable_assume_role"`
 	AutomaticSchema               types.Bool    `tfsdk:"automatic_schema"`
 	EnablePageChecksum            types.Bool    `tfsdk:"enable_page_checksum"`
 	EnableStatistics              types.Bool    `tfsdk:"enable_statistics"`
@@ -69,6 +62,13 @@ type OutputS3 struct {
 	ParquetVersion                types.String  `tfsdk:"parquet_version"`
 	Pipeline                      types.String  `tfsdk:"pipeline"`
 	ShouldLogInvalidRows          types.Bool    `tfsdk:"should_log_invalid_rows"`
+	KeyValueMetadata              []KeyValue    `tfsdk:"key_value_metadata"`
+	AWSAuth
+}
+
+type KeyValue struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
 }
 
 func (o *OutputS3) SetDefaults() {
@@ -77,22 +77,19 @@ func (o *OutputS3) SetDefaults() {
 
 func (o *OutputS3) FromCriblOutputS3(model cribl.OutputS3) {
 	o.ID = types.StringPointerValue(model.Id)
-	o.Type = types.StringPointerValue((*string)(model.Type))
+	// the type is always s3, only mirror it back when the config spells it
+	// out
+	if !o.Type.IsNull() {
+		o.Type = types.StringPointerValue((*string)(model.Type))
+	}
 	o.Description = types.StringPointerValue(model.Description)
 	o.Environment = types.StringPointerValue(model.Environment)
-
-	// if model.StreamTags != nil {
-	// 	streamTags, _ := types.ListValueFrom(context.Background(), types.StringType, model.StreamTags)
-	// 	o.StreamTags = streamTags
-	// }
-
-	if model.SystemFields != nil {
-		systemFields, _ := types.ListValueFrom(context.Background(), types.StringType, model.SystemFields)
-		o.SystemFields = systemFields
-	}
-
+	o.StreamTags = fromStringSlice(model.Streamtags)
+	o.SystemFields = fromStringSlice(model.SystemFields)
 	o.DeadletterEnabled = types.BoolPointerValue((*bool)(model.DeadletterEnabled))
+	o.DeadletterPath = types.StringPointerValue(model.DeadletterPath)
 	o.Bucket = types.StringValue(model.Bucket)
+	o.Region = types.StringPointerValue(model.Region)
 	o.DestPath = types.StringPointerValue(model.DestPath)
 	o.StagePath = types.StringValue(model.StagePath)
 	o.AddIdToStagePath = types.BoolPointerValue(model.AddIdToStagePath)
@@ -100,12 +97,7 @@ func (o *OutputS3) FromCriblOutputS3(model cribl.OutputS3) {
 	o.EmptyDirCleanupSec = types.Float32PointerValue(model.EmptyDirCleanupSec)
 	o.BaseFileName = types.StringPointerValue(model.BaseFileName)
 	o.FileNameSuffix = types.StringPointerValue(model.FileNameSuffix)
-
-	// if model.PartitioningFields != nil {
-	// 	partitioningFields, _ := types.ListValueFrom(context.Background(), types.StringType, model.PartitioningFields)
-	// 	o.PartitioningFields = partitioningFields
-	// }
-
+	o.PartitionExpr = types.StringPointerValue(model.PartitionExpr)
 	o.Format = types.StringPointerValue((*string)(model.Format))
 	o.Compress = types.StringPointerValue((*string)(model.Compress))
 	o.CompressionLevel = types.StringPointerValue((*string)(model.CompressionLevel))
@@ -115,32 +107,61 @@ func (o *OutputS3) FromCriblOutputS3(model cribl.OutputS3) {
 	o.MaxOpenFiles = types.Float32PointerValue(model.MaxOpenFiles)
 	o.MaxConcurrentFileParts = types.Float32PointerValue(model.MaxConcurrentFileParts)
 	o.MaxClosingFilesToBackpressure = types.Float32PointerValue(model.MaxClosingFilesToBackpressure)
+	o.MaxRetryNum = types.Float32PointerValue(model.MaxRetryNum)
 	o.HeaderLine = types.StringPointerValue(model.HeaderLine)
 	o.OnBackpressure = types.StringPointerValue((*string)(model.OnBackpressure))
 	o.OnDiskFullBackpressure = types.StringPointerValue((*string)(model.OnDiskFullBackpressure))
 	o.WriteHighWaterMark = types.Float32PointerValue(model.WriteHighWaterMark)
-	o.AwsAuthenticationMethod = types.StringPointerValue((*string)(model.AwsAuthenticationMethod))
-	o.AwsSecret = types.StringPointerValue(model.AwsSecret)
 	o.SignatureVersion = types.StringPointerValue((*string)(model.SignatureVersion))
 	o.ReuseConnections = types.BoolPointerValue(model.ReuseConnections)
 	o.RejectUnauthorized = types.BoolPointerValue(model.RejectUnauthorized)
 	o.ObjectACL = types.StringPointerValue((*string)(model.ObjectACL))
 	o.StorageClass = types.StringPointerValue((*string)(model.StorageClass))
+	o.ServerSideEncryption = types.StringPointerValue((*string)(model.ServerSideEncryption))
+	o.KmsKeyId = types.StringPointerValue(model.KmsKeyId)
+	o.Endpoint = types.StringPointerValue(model.Endpoint)
 	o.VerifyPermissions = types.BoolPointerValue(model.VerifyPermissions)
-	o.DurationSeconds = types.Float32PointerValue(model.DurationSeconds)
-	o.EnableAssumeRole = types.BoolPointerValue(model.EnableAssumeRole)
+	o.AutomaticSchema = types.BoolPointerValue(model.AutomaticSchema)
+	o.EnablePageChecksum = types.BoolPointerValue(model.EnablePageChecksum)
+	o.EnableStatistics = types.BoolPointerValue(model.EnableStatistics)
+	o.EnableWritePageIndex = types.BoolPointerValue(model.EnableWritePageIndex)
+	o.ParquetDataPageVersion = types.StringPointerValue((*string)(model.ParquetDataPageVersion))
+	o.ParquetPageSize = types.StringPointerValue(model.ParquetPageSize)
+	o.ParquetRowGroupLength = types.Float32PointerValue(model.ParquetRowGroupLength)
+	o.ParquetVersion = types.StringPointerValue((*string)(model.ParquetVersion))
+	o.Pipeline = types.StringPointerValue(model.Pipeline)
+	o.ShouldLogInvalidRows = types.BoolPointerValue(model.ShouldLogInvalidRows)
+	o.KeyValueMetadata = nil
+	if model.KeyValueMetadata != nil {
+		o.KeyValueMetadata = []KeyValue{}
+		for _, kv := range *model.KeyValueMetadata {
+			o.KeyValueMetadata = append(o.KeyValueMetadata, KeyValue{
+				Key:   types.StringValue(kv.Key),
+				Value: types.StringValue(kv.Value),
+			})
+		}
+	}
+	o.AWSAuth.fromCribl(criblAWSAuth{
+		AuthenticationMethod: (*string)(model.AwsAuthenticationMethod),
+		Secret:               model.AwsSecret,
+		EnableAssumeRole:     model.EnableAssumeRole,
+		AssumeRoleArn:        model.AssumeRoleArn,
+		AssumeRoleExternalId: model.AssumeRoleExternalId,
+		DurationSeconds:      model.DurationSeconds,
+	})
 }
 
 func (o *OutputS3) ToCriblOutputS3() cribl.OutputS3 {
-	return cribl.OutputS3{
+	aws := o.AWSAuth.toCribl()
+	out := cribl.OutputS3{
 		AddIdToStagePath:              o.AddIdToStagePath.ValueBoolPointer(),
-		AssumeRoleArn:                 o.AssumeRoleArn.ValueStringPointer(),
-		AssumeRoleExternalId:          o.AssumeRoleExternalId.ValueStringPointer(),
+		AssumeRoleArn:                 aws.AssumeRoleArn,
+		AssumeRoleExternalId:          aws.AssumeRoleExternalId,
 		AutomaticSchema:               o.AutomaticSchema.ValueBoolPointer(),
-		AwsApiKey:                     o.AwsApiKey.ValueStringPointer(),
-		AwsAuthenticationMethod:       (*cribl.OutputS3AwsAuthenticationMethod)(o.AwsAuthenticationMethod.ValueStringPointer()),
-		AwsSecret:                     o.AwsSecret.ValueStringPointer(),
-		AwsSecretKey:                  o.AwsSecretKey.ValueStringPointer(),
+		AwsApiKey:                     aws.ApiKey,
+		AwsAuthenticationMethod:       (*cribl.OutputS3AwsAuthenticationMethod)(aws.AuthenticationMethod),
+		AwsSecret:                     aws.Secret,
+		AwsSecretKey:                  aws.SecretKey,
 		BaseFileName:                  o.BaseFileName.ValueStringPointer(),
 		Bucket:                        o.Bucket.ValueString(),
 		Compress:                      (*cribl.OutputS3Compress)(o.Compress.ValueStringPointer()),
@@ -149,9 +170,9 @@ func (o *OutputS3) ToCriblOutputS3() cribl.OutputS3 {
 		DeadletterPath:                o.DeadletterPath.ValueStringPointer(),
 		Description:                   o.Description.ValueStringPointer(),
 		DestPath:                      o.DestPath.ValueStringPointer(),
-		DurationSeconds:               o.DurationSeconds.ValueFloat32Pointer(),
+		DurationSeconds:               aws.DurationSeconds,
 		EmptyDirCleanupSec:            o.EmptyDirCleanupSec.ValueFloat32Pointer(),
-		EnableAssumeRole:              o.EnableAssumeRole.ValueBoolPointer(),
+		EnableAssumeRole:              aws.EnableAssumeRole,
 		EnablePageChecksum:            o.EnablePageChecksum.ValueBoolPointer(),
 		EnableStatistics:              o.EnableStatistics.ValueBoolPointer(),
 		EnableWritePageIndex:          o.EnableWritePageIndex.ValueBoolPointer(),
@@ -187,8 +208,288 @@ func (o *OutputS3) ToCriblOutputS3() cribl.OutputS3 {
 		SignatureVersion:              (*cribl.OutputS3SignatureVersion)(o.SignatureVersion.ValueStringPointer()),
 		StagePath:                     o.StagePath.ValueString(),
 		StorageClass:                  (*cribl.OutputS3StorageClass)(o.StorageClass.ValueStringPointer()),
-		Type:                          (*cribl.OutputS3Type)(o.Type.ValueStringPointer()),
+		Streamtags:                    toStringSlice(o.StreamTags),
+		SystemFields:                  toStringSlice(o.SystemFields),
+		Type:                          lo.ToPtr(cribl.OutputS3Type("s3")),
 		VerifyPermissions:             o.VerifyPermissions.ValueBoolPointer(),
 		WriteHighWaterMark:            o.WriteHighWaterMark.ValueFloat32Pointer(),
 	}
+	if o.KeyValueMetadata != nil {
+		metadata := []cribl.OutputKeyValueMetadata{}
+		for _, kv := range o.KeyValueMetadata {
+			metadata = append(metadata, cribl.OutputKeyValueMetadata{
+				Key:   kv.Key.ValueString(),
+				Value: kv.Value.ValueString(),
+			})
+		}
+		out.KeyValueMetadata = &metadata
+	}
+	return out
+}
+
+// Output is a destination of any type, configured through a free-form config
+// object in Cribl's own attribute names.
+type Output struct {
+	ID     types.String  `tfsdk:"id"`
+	Type   types.String  `tfsdk:"type"`
+	Config types.Dynamic `tfsdk:"config"`
+}
+
+func (o *Output) ToCriblOutput() (cribl.Output, error) {
+	body, err := configBody(o.ID.ValueString(), o.Type.ValueString(), o.Config)
+	if err != nil {
+		return cribl.Output{}, err
+	}
+	return cribl.Output{Union: json.RawMessage(body)}, nil
+}
+
+func (o *Output) FromCriblOutput(ctx context.Context, model json.RawMessage) diag.Diagnostics {
+	kind, config, diags := configFromBody(ctx, model, o.Config)
+	if diags.HasError() {
+		return diags
+	}
+	o.Type = types.StringValue(kind)
+	o.Config = config
+	return diags
+}
+
+// OutputPQ groups the persistent queue settings that cribl keeps as flat
+// pq* attributes on every output.
+type OutputPQ struct {
+	Mode           types.String `tfsdk:"mode"`
+	MaxFileSize    types.String `tfsdk:"max_file_size"`
+	MaxSize        types.String `tfsdk:"max_size"`
+	Path           types.String `tfsdk:"path"`
+	Compress       types.String `tfsdk:"compress"`
+	OnBackpressure types.String `tfsdk:"on_backpressure"`
+}
+
+type OutputTLS struct {
+	Disabled           types.Bool   `tfsdk:"disabled"`
+	RejectUnauthorized types.Bool   `tfsdk:"reject_unauthorized"`
+	Servername         types.String `tfsdk:"servername"`
+	CertificateName    types.String `tfsdk:"certificate_name"`
+	CaPath             types.String `tfsdk:"ca_path"`
+	PrivKeyPath        types.String `tfsdk:"priv_key_path"`
+	CertPath           types.String `tfsdk:"cert_path"`
+	Passphrase         types.String `tfsdk:"passphrase"`
+	MinVersion         types.String `tfsdk:"min_version"`
+	MaxVersion         types.String `tfsdk:"max_version"`
+}
+
+// fromCriblOutputPQ returns nil when none of the pq attributes are set so an
+// unconfigured pq block doesn't show up as drift.
+func fromCriblOutputPQ(mode, maxFileSize, maxSize, path, compress, onBackpressure *string) *OutputPQ {
+	if mode == nil && maxFileSize == nil && maxSize == nil && path == nil && compress == nil && onBackpressure == nil {
+		return nil
+	}
+	return &OutputPQ{
+		Mode:           types.StringPointerValue(mode),
+		MaxFileSize:    types.StringPointerValue(maxFileSize),
+		MaxSize:        types.StringPointerValue(maxSize),
+		Path:           types.StringPointerValue(path),
+		Compress:       types.StringPointerValue(compress),
+		OnBackpressure: types.StringPointerValue(onBackpressure),
+	}
+}
+
+func (t *OutputTLS) toCribl() *cribl.OutputTlsClientSide {
+	if t == nil {
+		return nil
+	}
+	return &cribl.OutputTlsClientSide{
+		Disabled:           t.Disabled.ValueBoolPointer(),
+		RejectUnauthorized: t.RejectUnauthorized.ValueBoolPointer(),
+		Servername:         t.Servername.ValueStringPointer(),
+		CertificateName:    t.CertificateName.ValueStringPointer(),
+		CaPath:             t.CaPath.ValueStringPointer(),
+		PrivKeyPath:        t.PrivKeyPath.ValueStringPointer(),
+		CertPath:           t.CertPath.ValueStringPointer(),
+		Passphrase:         t.Passphrase.ValueStringPointer(),
+		MinVersion:         t.MinVersion.ValueStringPointer(),
+		MaxVersion:         t.MaxVersion.ValueStringPointer(),
+	}
+}
+
+func fromCriblOutputTLS(tls *cribl.OutputTlsClientSide) *OutputTLS {
+	if tls == nil {
+		return nil
+	}
+	return &OutputTLS{
+		Disabled:           types.BoolPointerValue(tls.Disabled),
+		RejectUnauthorized: types.BoolPointerValue(tls.RejectUnauthorized),
+		Servername:         types.StringPointerValue(tls.Servername),
+		CertificateName:    types.StringPointerValue(tls.CertificateName),
+		CaPath:             types.StringPointerValue(tls.CaPath),
+		PrivKeyPath:        types.StringPointerValue(tls.PrivKeyPath),
+		CertPath:           types.StringPointerValue(tls.CertPath),
+		Passphrase:         types.StringPointerValue(tls.Passphrase),
+		MinVersion:         types.StringPointerValue(tls.MinVersion),
+		MaxVersion:         types.StringPointerValue(tls.MaxVersion),
+	}
+}
+
+// ExtraHTTPField is a name/value pair sent with every request of an HTTP
+// based output, as a header or a query parameter.
+type ExtraHTTPField struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+type LoadBalancedURL struct {
+	URL    types.String  `tfsdk:"url"`
+	Weight types.Float32 `tfsdk:"weight"`
+}
+
+type ResponseRetrySetting struct {
+	HTTPStatus     types.Float32 `tfsdk:"http_status"`
+	InitialBackoff types.Float32 `tfsdk:"initial_backoff"`
+	BackoffRate    types.Float32 `tfsdk:"backoff_rate"`
+	MaxBackoff     types.Float32 `tfsdk:"max_backoff"`
+}
+
+// TimeoutRetrySettings enables retrying timed out requests when set.
+type TimeoutRetrySettings struct {
+	InitialBackoff types.Float32 `tfsdk:"initial_backoff"`
+	BackoffRate    types.Float32 `tfsdk:"backoff_rate"`
+	MaxBackoff     types.Float32 `tfsdk:"max_backoff"`
+}
+
+func toCriblExtraHTTPHeaders(headers []ExtraHTTPField) *[]cribl.OutputExtraHttpHeader {
+	if headers == nil {
+		return nil
+	}
+	out := []cribl.OutputExtraHttpHeader{}
+	for _, header := range headers {
+		out = append(out, cribl.OutputExtraHttpHeader{
+			Name:  header.Name.ValueStringPointer(),
+			Value: header.Value.ValueString(),
+		})
+	}
+	return &out
+}
+
+func fromCriblExtraHTTPHeaders(headers *[]cribl.OutputExtraHttpHeader) []ExtraHTTPField {
+	if headers == nil {
+		return nil
+	}
+	out := []ExtraHTTPField{}
+	for _, header := range *headers {
+		out = append(out, ExtraHTTPField{
+			Name:  types.StringPointerValue(header.Name),
+			Value: types.StringValue(header.Value),
+		})
+	}
+	return out
+}
+
+func toCriblExtraParams(params []ExtraHTTPField) *[]cribl.OutputExtraParam {
+	if params == nil {
+		return nil
+	}
+	out := []cribl.OutputExtraParam{}
+	for _, param := range params {
+		out = append(out, cribl.OutputExtraParam{
+			Name:  param.Name.ValueString(),
+			Value: param.Value.ValueString(),
+		})
+	}
+	return &out
+}
+
+func fromCriblExtraParams(params *[]cribl.OutputExtraParam) []ExtraHTTPField {
+	if params == nil {
+		return nil
+	}
+	out := []ExtraHTTPField{}
+	for _, param := range *params {
+		out = append(out, ExtraHTTPField{
+			Name:  types.StringValue(param.Name),
+			Value: types.StringValue(param.Value),
+		})
+	}
+	return out
+}
+
+func toCriblLoadBalancedURLs(urls []LoadBalancedURL) *[]cribl.OutputLoadBalancedUrl {
+	if urls == nil {
+		return nil
+	}
+	out := []cribl.OutputLoadBalancedUrl{}
+	for _, url := range urls {
+		out = append(out, cribl.OutputLoadBalancedUrl{
+			Url:    url.URL.ValueString(),
+			Weight: url.Weight.ValueFloat32Pointer(),
+		})
+	}
+	return &out
+}
+
+func fromCriblLoadBalancedURLs(urls *[]cribl.OutputLoadBalancedUrl) []LoadBalancedURL {
+	if urls == nil {
+		return nil
+	}
+	out := []LoadBalancedURL{}
+	for _, url := range *urls {
+		out = append(out, LoadBalancedURL{
+			URL:    types.StringValue(url.Url),
+			Weight: types.Float32PointerValue(url.Weight),
+		})
+	}
+	return out
+}
+
+func toCriblResponseRetrySettings(settings []ResponseRetrySetting) *[]cribl.OutputResponseRetrySetting {
+	if settings == nil {
+		return nil
+	}
+	out := []cribl.OutputResponseRetrySetting{}
+	for _, setting := range settings {
+		out = append(out, cribl.OutputResponseRetrySetting{
+			HttpStatus:     setting.HTTPStatus.ValueFloat32(),
+			InitialBackoff: setting.InitialBackoff.ValueFloat32Pointer(),
+			BackoffRate:    setting.BackoffRate.ValueFloat32Pointer(),
+			MaxBackoff:     setting.MaxBackoff.ValueFloat32Pointer(),
+		})
+	}
+	return &out
+}
+
+func fromCriblResponseRetrySettings(settings *[]cribl.OutputResponseRetrySetting) []ResponseRetrySetting {
+	if settings == nil {
+		return nil
+	}
+	out := []ResponseRetrySetting{}
+	for _, setting := range *settings {
+		out = append(out, ResponseRetrySetting{
+			HTTPStatus:     types.Float32Value(setting.HttpStatus),
+			InitialBackoff: types.Float32PointerValue(setting.InitialBackoff),
+			BackoffRate:    types.Float32PointerValue(setting.BackoffRate),
+			MaxBackoff:     types.Float32PointerValue(setting.MaxBackoff),
+		})
+	}
+	return out
+}
+
+func (t *TimeoutRetrySettings) toCribl() *cribl.OutputTimeoutRetrySettings {
+	if t == nil {
+		return nil
+	}
+	return &cribl.OutputTimeoutRetrySettings{
+		TimeoutRetry:   true,
+		InitialBackoff: t.InitialBackoff.ValueFloat32Pointer(),
+		BackoffRate:    t.BackoffRate.ValueFloat32Pointer(),
+		MaxBackoff:     t.MaxBackoff.ValueFloat32Pointer(),
+	}
+}
+
+func fromCriblTimeoutRetrySettings(settings *cribl.OutputTimeoutRetrySettings) *TimeoutRetrySettings {
+	if settings == nil || !settings.TimeoutRetry {
+		return nil
+	}
+	return &TimeoutRetrySettings{
+		InitialBackoff: types.Float32PointerValue(settings.InitialBackoff),
+		BackoffRate:    types.Float32PointerValue(settings.BackoffRate),
+		MaxBackoff:     types.Float32PointerValue(settings.MaxBackoff),
+	}
 }
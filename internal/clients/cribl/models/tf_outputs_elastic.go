@@ -0,0 +1,190 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type OutputElastic struct {
+	ID                            types.String           `tfsdk:"id"`
+	Description                   types.String           `tfsdk:"description"`
+	Environment                   types.String           `tfsdk:"environment"`
+	Pipeline                      types.String           `tfsdk:"pipeline"`
+	StreamTags                    types.List             `tfsdk:"stream_tags"`
+	SystemFields                  types.List             `tfsdk:"system_fields"`
+	URL                           types.String           `tfsdk:"url"`
+	URLs                          []LoadBalancedURL      `tfsdk:"urls"`
+	ExcludeSelf                   types.Bool             `tfsdk:"exclude_self"`
+	DNSResolvePeriodSec           types.Float32          `tfsdk:"dns_resolve_period_sec"`
+	LoadBalanceStatsPeriodSec     types.Float32          `tfsdk:"load_balance_stats_period_sec"`
+	UseRoundRobinDNS              types.Bool             `tfsdk:"use_round_robin_dns"`
+	Index                         types.String           `tfsdk:"index"`
+	DocType                       types.String           `tfsdk:"doc_type"`
+	ElasticPipeline               types.String           `tfsdk:"elastic_pipeline"`
+	ElasticVersion                types.String           `tfsdk:"elastic_version"`
+	WriteAction                   types.String           `tfsdk:"write_action"`
+	IncludeDocID                  types.Bool             `tfsdk:"include_doc_id"`
+	RetryPartialErrors            types.Bool             `tfsdk:"retry_partial_errors"`
+	Auth                          *ElasticAuth           `tfsdk:"auth"`
+	ExtraHTTPHeaders              []ExtraHTTPField       `tfsdk:"extra_http_headers"`
+	ExtraParams                   []ExtraHTTPField       `tfsdk:"extra_params"`
+	SafeHeaders                   types.List             `tfsdk:"safe_headers"`
+	FailedRequestLoggingMode      types.String           `tfsdk:"failed_request_logging_mode"`
+	Compress                      types.Bool             `tfsdk:"compress"`
+	Concurrency                   types.Float32          `tfsdk:"concurrency"`
+	MaxPayloadSizeKB              types.Float32          `tfsdk:"max_payload_size_kb"`
+	MaxPayloadEvents              types.Float32          `tfsdk:"max_payload_events"`
+	FlushPeriodSec                types.Float32          `tfsdk:"flush_period_sec"`
+	TimeoutSec                    types.Float32          `tfsdk:"timeout_sec"`
+	RejectUnauthorized            types.Bool             `tfsdk:"reject_unauthorized"`
+	ResponseRetrySettings         []ResponseRetrySetting `tfsdk:"response_retry_settings"`
+	TimeoutRetrySettings          *TimeoutRetrySettings  `tfsdk:"timeout_retry_settings"`
+	ResponseHonorRetryAfterHeader types.Bool             `tfsdk:"response_honor_retry_after_header"`
+	OnBackpressure                types.String           `tfsdk:"on_backpressure"`
+	PQ                            *OutputPQ              `tfsdk:"pq"`
+}
+
+// ElasticAuth enables authentication when set. auth_type picks which of the
+// credential attributes are used.
+type ElasticAuth struct {
+	AuthType          types.String `tfsdk:"auth_type"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	CredentialsSecret types.String `tfsdk:"credentials_secret"`
+	APIKey            types.String `tfsdk:"api_key"`
+	TextSecret        types.String `tfsdk:"text_secret"`
+}
+
+func (o *OutputElastic) ToCriblOutputElastic() cribl.OutputElastic {
+	pq := lo.FromPtr(o.PQ)
+	return cribl.OutputElastic{
+		Id:                            o.ID.ValueStringPointer(),
+		Type:                          cribl.OutputElasticType("elastic"),
+		Description:                   o.Description.ValueStringPointer(),
+		Environment:                   o.Environment.ValueStringPointer(),
+		Pipeline:                      o.Pipeline.ValueStringPointer(),
+		Streamtags:                    toStringSlice(o.StreamTags),
+		SystemFields:                  toStringSlice(o.SystemFields),
+		Url:                           o.URL.ValueStringPointer(),
+		Urls:                          toCriblLoadBalancedURLs(o.URLs),
+		LoadBalanced:                  lo.ToPtr(cribl.OutputElasticLoadBalanced(len(o.URLs) > 0)),
+		ExcludeSelf:                   o.ExcludeSelf.ValueBoolPointer(),
+		DnsResolvePeriodSec:           o.DNSResolvePeriodSec.ValueFloat32Pointer(),
+		LoadBalanceStatsPeriodSec:     o.LoadBalanceStatsPeriodSec.ValueFloat32Pointer(),
+		UseRoundRobinDns:              o.UseRoundRobinDNS.ValueBoolPointer(),
+		Index:                         o.Index.ValueString(),
+		DocType:                       o.DocType.ValueStringPointer(),
+		ElasticPipeline:               o.ElasticPipeline.ValueStringPointer(),
+		ElasticVersion:                (*cribl.OutputElasticElasticVersion)(o.ElasticVersion.ValueStringPointer()),
+		WriteAction:                   (*cribl.OutputElasticWriteAction)(o.WriteAction.ValueStringPointer()),
+		IncludeDocId:                  o.IncludeDocID.ValueBoolPointer(),
+		RetryPartialErrors:            o.RetryPartialErrors.ValueBoolPointer(),
+		Auth:                          o.Auth.toCribl(),
+		ExtraHttpHeaders:              toCriblExtraHTTPHeaders(o.ExtraHTTPHeaders),
+		ExtraParams:                   toCriblExtraParams(o.ExtraParams),
+		SafeHeaders:                   toStringSlice(o.SafeHeaders),
+		FailedRequestLoggingMode:      (*cribl.OutputElasticFailedRequestLoggingMode)(o.FailedRequestLoggingMode.ValueStringPointer()),
+		Compress:                      o.Compress.ValueBoolPointer(),
+		Concurrency:                   o.Concurrency.ValueFloat32Pointer(),
+		MaxPayloadSizeKB:              o.MaxPayloadSizeKB.ValueFloat32Pointer(),
+		MaxPayloadEvents:              o.MaxPayloadEvents.ValueFloat32Pointer(),
+		FlushPeriodSec:                o.FlushPeriodSec.ValueFloat32Pointer(),
+		TimeoutSec:                    o.TimeoutSec.ValueFloat32Pointer(),
+		RejectUnauthorized:            o.RejectUnauthorized.ValueBoolPointer(),
+		ResponseRetrySettings:         toCriblResponseRetrySettings(o.ResponseRetrySettings),
+		TimeoutRetrySettings:          o.TimeoutRetrySettings.toCribl(),
+		ResponseHonorRetryAfterHeader: o.ResponseHonorRetryAfterHeader.ValueBoolPointer(),
+		OnBackpressure:                (*cribl.OutputElasticOnBackpressure)(o.OnBackpressure.ValueStringPointer()),
+		PqMode:                        (*cribl.OutputElasticPqMode)(pq.Mode.ValueStringPointer()),
+		PqMaxFileSize:                 pq.MaxFileSize.ValueStringPointer(),
+		PqMaxSize:                     pq.MaxSize.ValueStringPointer(),
+		PqPath:                        pq.Path.ValueStringPointer(),
+		PqCompress:                    (*cribl.OutputElasticPqCompress)(pq.Compress.ValueStringPointer()),
+		PqOnBackpressure:              (*cribl.OutputElasticPqOnBackpressure)(pq.OnBackpressure.ValueStringPointer()),
+	}
+}
+
+func (o *OutputElastic) FromCriblOutputElastic(model cribl.OutputElastic) {
+	o.ID = types.StringPointerValue(model.Id)
+	o.Description = types.StringPointerValue(model.Description)
+	o.Environment = types.StringPointerValue(model.Environment)
+	o.Pipeline = types.StringPointerValue(model.Pipeline)
+	o.StreamTags = fromStringSlice(model.Streamtags)
+	o.SystemFields = fromStringSlice(model.SystemFields)
+
+	// cribl keeps both the single url and the endpoint list around when the
+	// load balanced toggle is flipped, only the active one is managed here
+	o.URL = types.StringNull()
+	o.URLs = nil
+	if lo.FromPtr(model.LoadBalanced) {
+		o.URLs = fromCriblLoadBalancedURLs(model.Urls)
+	} else {
+		o.URL = types.StringPointerValue(model.Url)
+	}
+
+	o.ExcludeSelf = types.BoolPointerValue(model.ExcludeSelf)
+	o.DNSResolvePeriodSec = types.Float32PointerValue(model.DnsResolvePeriodSec)
+	o.LoadBalanceStatsPeriodSec = types.Float32PointerValue(model.LoadBalanceStatsPeriodSec)
+	o.UseRoundRobinDNS = types.BoolPointerValue(model.UseRoundRobinDns)
+	o.Index = types.StringValue(model.Index)
+	o.DocType = types.StringPointerValue(model.DocType)
+	o.ElasticPipeline = types.StringPointerValue(model.ElasticPipeline)
+	o.ElasticVersion = types.StringPointerValue((*string)(model.ElasticVersion))
+	o.WriteAction = types.StringPointerValue((*string)(model.WriteAction))
+	o.IncludeDocID = types.BoolPointerValue(model.IncludeDocId)
+	o.RetryPartialErrors = types.BoolPointerValue(model.RetryPartialErrors)
+	o.Auth = fromCriblElasticAuth(model.Auth)
+	o.ExtraHTTPHeaders = fromCriblExtraHTTPHeaders(model.ExtraHttpHeaders)
+	o.ExtraParams = fromCriblExtraParams(model.ExtraParams)
+	o.SafeHeaders = fromStringSlice(model.SafeHeaders)
+	o.FailedRequestLoggingMode = types.StringPointerValue((*string)(model.FailedRequestLoggingMode))
+	o.Compress = types.BoolPointerValue(model.Compress)
+	o.Concurrency = types.Float32PointerValue(model.Concurrency)
+	o.MaxPayloadSizeKB = types.Float32PointerValue(model.MaxPayloadSizeKB)
+	o.MaxPayloadEvents = types.Float32PointerValue(model.MaxPayloadEvents)
+	o.FlushPeriodSec = types.Float32PointerValue(model.FlushPeriodSec)
+	o.TimeoutSec = types.Float32PointerValue(model.TimeoutSec)
+	o.RejectUnauthorized = types.BoolPointerValue(model.RejectUnauthorized)
+	o.ResponseRetrySettings = fromCriblResponseRetrySettings(model.ResponseRetrySettings)
+	o.TimeoutRetrySettings = fromCriblTimeoutRetrySettings(model.TimeoutRetrySettings)
+	o.ResponseHonorRetryAfterHeader = types.BoolPointerValue(model.ResponseHonorRetryAfterHeader)
+	o.OnBackpressure = types.StringPointerValue((*string)(model.OnBackpressure))
+	o.PQ = fromCriblOutputPQ(
+		(*string)(model.PqMode),
+		model.PqMaxFileSize,
+		model.PqMaxSize,
+		model.PqPath,
+		(*string)(model.PqCompress),
+		(*string)(model.PqOnBackpressure),
+	)
+}
+
+func (a *ElasticAuth) toCribl() *cribl.OutputElasticAuth {
+	if a == nil {
+		return nil
+	}
+	return &cribl.OutputElasticAuth{
+		Disabled:          false,
+		AuthType:          (*cribl.OutputElasticAuthAuthType)(a.AuthType.ValueStringPointer()),
+		Username:          a.Username.ValueStringPointer(),
+		Password:          a.Password.ValueStringPointer(),
+		CredentialsSecret: a.CredentialsSecret.ValueStringPointer(),
+		ManualAPIKey:      a.APIKey.ValueStringPointer(),
+		TextSecret:        a.TextSecret.ValueStringPointer(),
+	}
+}
+
+func fromCriblElasticAuth(auth *cribl.OutputElasticAuth) *ElasticAuth {
+	if auth == nil || auth.Disabled {
+		return nil
+	}
+	return &ElasticAuth{
+		AuthType:          types.StringPointerValue((*string)(auth.AuthType)),
+		Username:          types.StringPointerValue(auth.Username),
+		Password:          types.StringPointerValue(auth.Password),
+		CredentialsSecret: types.StringPointerValue(auth.CredentialsSecret),
+		APIKey:            types.StringPointerValue(auth.ManualAPIKey),
+		TextSecret:        types.StringPointerValue(auth.TextSecret),
+	}
+}
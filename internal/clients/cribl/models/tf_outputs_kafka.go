@@ -0,0 +1,122 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type OutputKafka struct {
+	ID                        types.String               `tfsdk:"id"`
+	Description               types.String               `tfsdk:"description"`
+	Environment               types.String               `tfsdk:"environment"`
+	Pipeline                  types.String               `tfsdk:"pipeline"`
+	StreamTags                types.List                 `tfsdk:"stream_tags"`
+	SystemFields              types.List                 `tfsdk:"system_fields"`
+	Brokers                   types.List                 `tfsdk:"brokers"`
+	Topic                     types.String               `tfsdk:"topic"`
+	Ack                       types.Int64                `tfsdk:"ack"`
+	Format                    types.String               `tfsdk:"format"`
+	Compression               types.String               `tfsdk:"compression"`
+	ProtobufLibraryID         types.String               `tfsdk:"protobuf_library_id"`
+	MaxRecordSizeKB           types.Float32              `tfsdk:"max_record_size_kb"`
+	FlushEventCount           types.Float32              `tfsdk:"flush_event_count"`
+	FlushPeriodSec            types.Float32              `tfsdk:"flush_period_sec"`
+	ConnectionTimeout         types.Float32              `tfsdk:"connection_timeout"`
+	RequestTimeout            types.Float32              `tfsdk:"request_timeout"`
+	MaxRetries                types.Float32              `tfsdk:"max_retries"`
+	MaxBackOff                types.Float32              `tfsdk:"max_back_off"`
+	InitialBackoff            types.Float32              `tfsdk:"initial_backoff"`
+	BackoffRate               types.Float32              `tfsdk:"backoff_rate"`
+	AuthenticationTimeout     types.Float32              `tfsdk:"authentication_timeout"`
+	ReauthenticationThreshold types.Float32              `tfsdk:"reauthentication_threshold"`
+	SASL                      *KafkaSASL                 `tfsdk:"sasl"`
+	TLS                       *OutputTLS                 `tfsdk:"tls"`
+	SchemaRegistry            *OutputKafkaSchemaRegistry `tfsdk:"schema_registry"`
+	OnBackpressure            types.String               `tfsdk:"on_backpressure"`
+	PQ                        *OutputPQ                  `tfsdk:"pq"`
+}
+
+func (o *OutputKafka) ToCriblOutputKafka() cribl.OutputKafka {
+	pq := lo.FromPtr(o.PQ)
+	out := cribl.OutputKafka{
+		Id:                        o.ID.ValueStringPointer(),
+		Type:                      lo.ToPtr(cribl.OutputKafkaTypeKafka),
+		Description:               o.Description.ValueStringPointer(),
+		Environment:               o.Environment.ValueStringPointer(),
+		Pipeline:                  o.Pipeline.ValueStringPointer(),
+		Streamtags:                toStringSlice(o.StreamTags),
+		SystemFields:              toStringSlice(o.SystemFields),
+		Brokers:                   lo.FromPtr(toStringSlice(o.Brokers)),
+		Topic:                     o.Topic.ValueString(),
+		Format:                    (*cribl.OutputKafkaFormat)(o.Format.ValueStringPointer()),
+		Compression:               (*cribl.OutputKafkaCompression)(o.Compression.ValueStringPointer()),
+		ProtobufLibraryId:         o.ProtobufLibraryID.ValueStringPointer(),
+		MaxRecordSizeKB:           o.MaxRecordSizeKB.ValueFloat32Pointer(),
+		FlushEventCount:           o.FlushEventCount.ValueFloat32Pointer(),
+		FlushPeriodSec:            o.FlushPeriodSec.ValueFloat32Pointer(),
+		ConnectionTimeout:         o.ConnectionTimeout.ValueFloat32Pointer(),
+		RequestTimeout:            o.RequestTimeout.ValueFloat32Pointer(),
+		MaxRetries:                o.MaxRetries.ValueFloat32Pointer(),
+		MaxBackOff:                o.MaxBackOff.ValueFloat32Pointer(),
+		InitialBackoff:            o.InitialBackoff.ValueFloat32Pointer(),
+		BackoffRate:               o.BackoffRate.ValueFloat32Pointer(),
+		AuthenticationTimeout:     o.AuthenticationTimeout.ValueFloat32Pointer(),
+		ReauthenticationThreshold: o.ReauthenticationThreshold.ValueFloat32Pointer(),
+		Sasl:                      o.SASL.toCribl(),
+		Tls:                       o.TLS.toCribl(),
+		KafkaSchemaRegistry:       o.SchemaRegistry.toCribl(),
+		OnBackpressure:            (*cribl.OutputKafkaOnBackpressure)(o.OnBackpressure.ValueStringPointer()),
+		PqMode:                    (*cribl.OutputKafkaPqMode)(pq.Mode.ValueStringPointer()),
+		PqMaxFileSize:             pq.MaxFileSize.ValueStringPointer(),
+		PqMaxSize:                 pq.MaxSize.ValueStringPointer(),
+		PqPath:                    pq.Path.ValueStringPointer(),
+		PqCompress:                (*cribl.OutputKafkaPqCompress)(pq.Compress.ValueStringPointer()),
+		PqOnBackpressure:          (*cribl.OutputKafkaPqOnBackpressure)(pq.OnBackpressure.ValueStringPointer()),
+	}
+	if !o.Ack.IsNull() {
+		out.Ack = lo.ToPtr(cribl.OutputKafkaAck(o.Ack.ValueInt64()))
+	}
+	return out
+}
+
+func (o *OutputKafka) FromCriblOutputKafka(model cribl.OutputKafka) {
+	o.ID = types.StringPointerValue(model.Id)
+	o.Description = types.StringPointerValue(model.Description)
+	o.Environment = types.StringPointerValue(model.Environment)
+	o.Pipeline = types.StringPointerValue(model.Pipeline)
+	o.StreamTags = fromStringSlice(model.Streamtags)
+	o.SystemFields = fromStringSlice(model.SystemFields)
+	o.Brokers = fromStringSlice(&model.Brokers)
+	o.Topic = types.StringValue(model.Topic)
+	o.Ack = types.Int64Null()
+	if model.Ack != nil {
+		o.Ack = types.Int64Value(int64(*model.Ack))
+	}
+	o.Format = types.StringPointerValue((*string)(model.Format))
+	o.Compression = types.StringPointerValue((*string)(model.Compression))
+	o.ProtobufLibraryID = types.StringPointerValue(model.ProtobufLibraryId)
+	o.MaxRecordSizeKB = types.Float32PointerValue(model.MaxRecordSizeKB)
+	o.FlushEventCount = types.Float32PointerValue(model.FlushEventCount)
+	o.FlushPeriodSec = types.Float32PointerValue(model.FlushPeriodSec)
+	o.ConnectionTimeout = types.Float32PointerValue(model.ConnectionTimeout)
+	o.RequestTimeout = types.Float32PointerValue(model.RequestTimeout)
+	o.MaxRetries = types.Float32PointerValue(model.MaxRetries)
+	o.MaxBackOff = types.Float32PointerValue(model.MaxBackOff)
+	o.InitialBackoff = types.Float32PointerValue(model.InitialBackoff)
+	o.BackoffRate = types.Float32PointerValue(model.BackoffRate)
+	o.AuthenticationTimeout = types.Float32PointerValue(model.AuthenticationTimeout)
+	o.ReauthenticationThreshold = types.Float32PointerValue(model.ReauthenticationThreshold)
+	o.SASL = fromCriblKafkaSASL(model.Sasl)
+	o.TLS = fromCriblOutputTLS(model.Tls)
+	o.SchemaRegistry = fromCriblOutputKafkaSchemaRegistry(model.KafkaSchemaRegistry)
+	o.OnBackpressure = types.StringPointerValue((*string)(model.OnBackpressure))
+	o.PQ = fromCriblOutputPQ(
+		(*string)(model.PqMode),
+		model.PqMaxFileSize,
+		model.PqMaxSize,
+		model.PqPath,
+		(*string)(model.PqCompress),
+		(*string)(model.PqOnBackpressure),
+	)
+}
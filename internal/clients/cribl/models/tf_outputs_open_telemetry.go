@@ -0,0 +1,186 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type OutputOpenTelemetry struct {
+	ID                          types.String            `tfsdk:"id"`
+	Description                 types.String            `tfsdk:"description"`
+	Environment                 types.String            `tfsdk:"environment"`
+	Pipeline                    types.String            `tfsdk:"pipeline"`
+	StreamTags                  types.List              `tfsdk:"stream_tags"`
+	SystemFields                types.List              `tfsdk:"system_fields"`
+	Protocol                    types.String            `tfsdk:"protocol"`
+	OtlpVersion                 types.String            `tfsdk:"otlp_version"`
+	Endpoint                    types.String            `tfsdk:"endpoint"`
+	Compress                    types.String            `tfsdk:"compress"`
+	HTTPCompress                types.String            `tfsdk:"http_compress"`
+	HTTPTracesEndpointOverride  types.String            `tfsdk:"http_traces_endpoint_override"`
+	HTTPMetricsEndpointOverride types.String            `tfsdk:"http_metrics_endpoint_override"`
+	HTTPLogsEndpointOverride    types.String            `tfsdk:"http_logs_endpoint_override"`
+	Metadata                    []OpenTelemetryMetadata `tfsdk:"metadata"`
+	HTTPAuth
+	ExtraHTTPHeaders              []ExtraHTTPField       `tfsdk:"extra_http_headers"`
+	SafeHeaders                   types.List             `tfsdk:"safe_headers"`
+	FailedRequestLoggingMode      types.String           `tfsdk:"failed_request_logging_mode"`
+	Concurrency                   types.Float32          `tfsdk:"concurrency"`
+	ConnectionTimeout             types.Float32          `tfsdk:"connection_timeout"`
+	KeepAlive                     types.Bool             `tfsdk:"keep_alive"`
+	KeepAliveTime                 types.Float32          `tfsdk:"keep_alive_time"`
+	MaxPayloadSizeKB              types.Float32          `tfsdk:"max_payload_size_kb"`
+	FlushPeriodSec                types.Float32          `tfsdk:"flush_period_sec"`
+	TimeoutSec                    types.Float32          `tfsdk:"timeout_sec"`
+	UseRoundRobinDNS              types.Bool             `tfsdk:"use_round_robin_dns"`
+	RejectUnauthorized            types.Bool             `tfsdk:"reject_unauthorized"`
+	ResponseRetrySettings         []ResponseRetrySetting `tfsdk:"response_retry_settings"`
+	TimeoutRetrySettings          *TimeoutRetrySettings  `tfsdk:"timeout_retry_settings"`
+	ResponseHonorRetryAfterHeader types.Bool             `tfsdk:"response_honor_retry_after_header"`
+	TLS                           *OutputTLS             `tfsdk:"tls"`
+	OnBackpressure                types.String           `tfsdk:"on_backpressure"`
+	PQ                            *OutputPQ              `tfsdk:"pq"`
+}
+
+// OpenTelemetryMetadata is a key/value pair sent with every gRPC request.
+type OpenTelemetryMetadata struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (o *OutputOpenTelemetry) ToCriblOutputOpenTelemetry() cribl.OutputOpenTelemetry {
+	pq := lo.FromPtr(o.PQ)
+	out := cribl.OutputOpenTelemetry{
+		Id:                            o.ID.ValueStringPointer(),
+		Type:                          cribl.OutputOpenTelemetryType("open_telemetry"),
+		Description:                   o.Description.ValueStringPointer(),
+		Environment:                   o.Environment.ValueStringPointer(),
+		Pipeline:                      o.Pipeline.ValueStringPointer(),
+		Streamtags:                    toStringSlice(o.StreamTags),
+		SystemFields:                  toStringSlice(o.SystemFields),
+		Protocol:                      (*cribl.OutputOpenTelemetryProtocol)(o.Protocol.ValueStringPointer()),
+		OtlpVersion:                   (*cribl.OutputOpenTelemetryOtlpVersion)(o.OtlpVersion.ValueStringPointer()),
+		Endpoint:                      o.Endpoint.ValueString(),
+		Compress:                      (*cribl.OutputOpenTelemetryCompress)(o.Compress.ValueStringPointer()),
+		HttpCompress:                  (*cribl.OutputOpenTelemetryHttpCompress)(o.HTTPCompress.ValueStringPointer()),
+		HttpTracesEndpointOverride:    o.HTTPTracesEndpointOverride.ValueStringPointer(),
+		HttpMetricsEndpointOverride:   o.HTTPMetricsEndpointOverride.ValueStringPointer(),
+		HttpLogsEndpointOverride:      o.HTTPLogsEndpointOverride.ValueStringPointer(),
+		AuthType:                      (*cribl.OutputOpenTelemetryAuthType)(o.AuthType.ValueStringPointer()),
+		Username:                      o.Username.ValueStringPointer(),
+		Password:                      o.Password.ValueStringPointer(),
+		Token:                         o.Token.ValueStringPointer(),
+		CredentialsSecret:             o.CredentialsSecret.ValueStringPointer(),
+		TextSecret:                    o.TextSecret.ValueStringPointer(),
+		LoginUrl:                      o.LoginURL.ValueStringPointer(),
+		SecretParamName:               o.SecretParamName.ValueStringPointer(),
+		Secret:                        o.Secret.ValueStringPointer(),
+		TokenAttributeName:            o.TokenAttributeName.ValueStringPointer(),
+		AuthHeaderExpr:                o.AuthHeaderExpr.ValueStringPointer(),
+		TokenTimeoutSecs:              o.TokenTimeoutSecs.ValueFloat32Pointer(),
+		OauthParams:                   toCriblOauthParams(o.OauthParams),
+		OauthHeaders:                  toCriblOauthHeaders(o.OauthHeaders),
+		ExtraHttpHeaders:              toCriblExtraHTTPHeaders(o.ExtraHTTPHeaders),
+		SafeHeaders:                   toStringSlice(o.SafeHeaders),
+		FailedRequestLoggingMode:      (*cribl.OutputOpenTelemetryFailedRequestLoggingMode)(o.FailedRequestLoggingMode.ValueStringPointer()),
+		Concurrency:                   o.Concurrency.ValueFloat32Pointer(),
+		ConnectionTimeout:             o.ConnectionTimeout.ValueFloat32Pointer(),
+		KeepAlive:                     o.KeepAlive.ValueBoolPointer(),
+		KeepAliveTime:                 o.KeepAliveTime.ValueFloat32Pointer(),
+		MaxPayloadSizeKB:              o.MaxPayloadSizeKB.ValueFloat32Pointer(),
+		FlushPeriodSec:                o.FlushPeriodSec.ValueFloat32Pointer(),
+		TimeoutSec:                    o.TimeoutSec.ValueFloat32Pointer(),
+		UseRoundRobinDns:              o.UseRoundRobinDNS.ValueBoolPointer(),
+		RejectUnauthorized:            o.RejectUnauthorized.ValueBoolPointer(),
+		ResponseRetrySettings:         toCriblResponseRetrySettings(o.ResponseRetrySettings),
+		TimeoutRetrySettings:          o.TimeoutRetrySettings.toCribl(),
+		ResponseHonorRetryAfterHeader: o.ResponseHonorRetryAfterHeader.ValueBoolPointer(),
+		Tls:                           o.TLS.toCribl(),
+		OnBackpressure:                (*cribl.OutputOpenTelemetryOnBackpressure)(o.OnBackpressure.ValueStringPointer()),
+		PqMode:                        (*cribl.OutputOpenTelemetryPqMode)(pq.Mode.ValueStringPointer()),
+		PqMaxFileSize:                 pq.MaxFileSize.ValueStringPointer(),
+		PqMaxSize:                     pq.MaxSize.ValueStringPointer(),
+		PqPath:                        pq.Path.ValueStringPointer(),
+		PqCompress:                    (*cribl.OutputOpenTelemetryPqCompress)(pq.Compress.ValueStringPointer()),
+		PqOnBackpressure:              (*cribl.OutputOpenTelemetryPqOnBackpressure)(pq.OnBackpressure.ValueStringPointer()),
+	}
+	if o.Metadata != nil {
+		metadata := []cribl.OutputOpenTelemetryMetadata{}
+		for _, m := range o.Metadata {
+			metadata = append(metadata, cribl.OutputOpenTelemetryMetadata{
+				Key:   m.Key.ValueString(),
+				Value: m.Value.ValueString(),
+			})
+		}
+		out.Metadata = &metadata
+	}
+	return out
+}
+
+func (o *OutputOpenTelemetry) FromCriblOutputOpenTelemetry(model cribl.OutputOpenTelemetry) {
+	o.ID = types.StringPointerValue(model.Id)
+	o.Description = types.StringPointerValue(model.Description)
+	o.Environment = types.StringPointerValue(model.Environment)
+	o.Pipeline = types.StringPointerValue(model.Pipeline)
+	o.StreamTags = fromStringSlice(model.Streamtags)
+	o.SystemFields = fromStringSlice(model.SystemFields)
+	o.Protocol = types.StringPointerValue((*string)(model.Protocol))
+	o.OtlpVersion = types.StringPointerValue((*string)(model.OtlpVersion))
+	o.Endpoint = types.StringValue(model.Endpoint)
+	o.Compress = types.StringPointerValue((*string)(model.Compress))
+	o.HTTPCompress = types.StringPointerValue((*string)(model.HttpCompress))
+	o.HTTPTracesEndpointOverride = types.StringPointerValue(model.HttpTracesEndpointOverride)
+	o.HTTPMetricsEndpointOverride = types.StringPointerValue(model.HttpMetricsEndpointOverride)
+	o.HTTPLogsEndpointOverride = types.StringPointerValue(model.HttpLogsEndpointOverride)
+	o.AuthType = types.StringPointerValue((*string)(model.AuthType))
+	o.Username = types.StringPointerValue(model.Username)
+	o.Password = types.StringPointerValue(model.Password)
+	o.Token = types.StringPointerValue(model.Token)
+	o.CredentialsSecret = types.StringPointerValue(model.CredentialsSecret)
+	o.TextSecret = types.StringPointerValue(model.TextSecret)
+	o.LoginURL = types.StringPointerValue(model.LoginUrl)
+	o.SecretParamName = types.StringPointerValue(model.SecretParamName)
+	o.Secret = types.StringPointerValue(model.Secret)
+	o.TokenAttributeName = types.StringPointerValue(model.TokenAttributeName)
+	o.AuthHeaderExpr = types.StringPointerValue(model.AuthHeaderExpr)
+	o.TokenTimeoutSecs = types.Float32PointerValue(model.TokenTimeoutSecs)
+	o.OauthParams = fromCriblOauthParams(model.OauthParams)
+	o.OauthHeaders = fromCriblOauthHeaders(model.OauthHeaders)
+	o.ExtraHTTPHeaders = fromCriblExtraHTTPHeaders(model.ExtraHttpHeaders)
+	o.SafeHeaders = fromStringSlice(model.SafeHeaders)
+	o.FailedRequestLoggingMode = types.StringPointerValue((*string)(model.FailedRequestLoggingMode))
+	o.Concurrency = types.Float32PointerValue(model.Concurrency)
+	o.ConnectionTimeout = types.Float32PointerValue(model.ConnectionTimeout)
+	o.KeepAlive = types.BoolPointerValue(model.KeepAlive)
+	o.KeepAliveTime = types.Float32PointerValue(model.KeepAliveTime)
+	o.MaxPayloadSizeKB = types.Float32PointerValue(model.MaxPayloadSizeKB)
+	o.FlushPeriodSec = types.Float32PointerValue(model.FlushPeriodSec)
+	o.TimeoutSec = types.Float32PointerValue(model.TimeoutSec)
+	o.UseRoundRobinDNS = types.BoolPointerValue(model.UseRoundRobinDns)
+	o.RejectUnauthorized = types.BoolPointerValue(model.RejectUnauthorized)
+	o.ResponseRetrySettings = fromCriblResponseRetrySettings(model.ResponseRetrySettings)
+	o.TimeoutRetrySettings = fromCriblTimeoutRetrySettings(model.TimeoutRetrySettings)
+	o.ResponseHonorRetryAfterHeader = types.BoolPointerValue(model.ResponseHonorRetryAfterHeader)
+	o.TLS = fromCriblOutputTLS(model.Tls)
+	o.OnBackpressure = types.StringPointerValue((*string)(model.OnBackpressure))
+	o.PQ = fromCriblOutputPQ(
+		(*string)(model.PqMode),
+		model.PqMaxFileSize,
+		model.PqMaxSize,
+		model.PqPath,
+		(*string)(model.PqCompress),
+		(*string)(model.PqOnBackpressure),
+	)
+
+	o.Metadata = nil
+	if model.Metadata != nil {
+		o.Metadata = []OpenTelemetryMetadata{}
+	}
+	for _, m := range lo.FromPtr(model.Metadata) {
+		o.Metadata = append(o.Metadata, OpenTelemetryMetadata{
+			Key:   types.StringValue(m.Key),
+			Value: types.StringValue(m.Value),
+		})
+	}
+}
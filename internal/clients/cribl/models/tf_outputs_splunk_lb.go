@@ -0,0 +1,185 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type OutputSplunkLb struct {
+	ID                           types.String              `tfsdk:"id"`
+	Description                  types.String              `tfsdk:"description"`
+	Environment                  types.String              `tfsdk:"environment"`
+	Pipeline                     types.String              `tfsdk:"pipeline"`
+	StreamTags                   types.List                `tfsdk:"stream_tags"`
+	SystemFields                 types.List                `tfsdk:"system_fields"`
+	Hosts                        []SplunkLbHost            `tfsdk:"hosts"`
+	IndexerDiscovery             *SplunkLbIndexerDiscovery `tfsdk:"indexer_discovery"`
+	AuthType                     types.String              `tfsdk:"auth_type"`
+	AuthToken                    types.String              `tfsdk:"auth_token"`
+	TextSecret                   types.String              `tfsdk:"text_secret"`
+	TLS                          *OutputTLS                `tfsdk:"tls"`
+	DNSResolvePeriodSec          types.Float32             `tfsdk:"dns_resolve_period_sec"`
+	LoadBalanceStatsPeriodSec    types.Float32             `tfsdk:"load_balance_stats_period_sec"`
+	MaxConcurrentSenders         types.Float32             `tfsdk:"max_concurrent_senders"`
+	ExcludeSelf                  types.Bool                `tfsdk:"exclude_self"`
+	NestedFields                 types.String              `tfsdk:"nested_fields"`
+	ThrottleRatePerSec           types.String              `tfsdk:"throttle_rate_per_sec"`
+	ConnectionTimeout            types.Float32             `tfsdk:"connection_timeout"`
+	WriteTimeout                 types.Float32             `tfsdk:"write_timeout"`
+	EnableMultiMetrics           types.Bool                `tfsdk:"enable_multi_metrics"`
+	EnableACK                    types.Bool                `tfsdk:"enable_ack"`
+	LogFailedRequests            types.Bool                `tfsdk:"log_failed_requests"`
+	MaxS2SVersion                types.String              `tfsdk:"max_s2s_version"`
+	MaxFailedHealthChecks        types.Float32             `tfsdk:"max_failed_health_checks"`
+	SenderUnhealthyTimeAllowance types.Float32             `tfsdk:"sender_unhealthy_time_allowance"`
+	OnBackpressure               types.String              `tfsdk:"on_backpressure"`
+	PQ                           *OutputPQ                 `tfsdk:"pq"`
+}
+
+type SplunkLbHost struct {
+	Host       types.String  `tfsdk:"host"`
+	Port       types.Float32 `tfsdk:"port"`
+	Servername types.String  `tfsdk:"servername"`
+	TLS        types.String  `tfsdk:"tls"`
+	Weight     types.Float32 `tfsdk:"weight"`
+}
+
+type SplunkLbIndexerDiscovery struct {
+	MasterURI          types.String  `tfsdk:"master_uri"`
+	Site               types.String  `tfsdk:"site"`
+	RefreshIntervalSec types.Float32 `tfsdk:"refresh_interval_sec"`
+	RejectUnauthorized types.Bool    `tfsdk:"reject_unauthorized"`
+	AuthType           types.String  `tfsdk:"auth_type"`
+	AuthToken          types.String  `tfsdk:"auth_token"`
+	TextSecret         types.String  `tfsdk:"text_secret"`
+}
+
+func (o *OutputSplunkLb) ToCriblOutputSplunkLb() cribl.OutputSplunkLb {
+	pq := lo.FromPtr(o.PQ)
+	out := cribl.OutputSplunkLb{
+		Id:                           o.ID.ValueStringPointer(),
+		Type:                         cribl.OutputSplunkLbType("splunk_lb"),
+		Description:                  o.Description.ValueStringPointer(),
+		Environment:                  o.Environment.ValueStringPointer(),
+		Pipeline:                     o.Pipeline.ValueStringPointer(),
+		Streamtags:                   toStringSlice(o.StreamTags),
+		SystemFields:                 toStringSlice(o.SystemFields),
+		AuthType:                     (*cribl.OutputSplunkLbAuthType)(o.AuthType.ValueStringPointer()),
+		AuthToken:                    o.AuthToken.ValueStringPointer(),
+		TextSecret:                   o.TextSecret.ValueStringPointer(),
+		Tls:                          o.TLS.toCribl(),
+		DnsResolvePeriodSec:          o.DNSResolvePeriodSec.ValueFloat32Pointer(),
+		LoadBalanceStatsPeriodSec:    o.LoadBalanceStatsPeriodSec.ValueFloat32Pointer(),
+		MaxConcurrentSenders:         o.MaxConcurrentSenders.ValueFloat32Pointer(),
+		ExcludeSelf:                  o.ExcludeSelf.ValueBoolPointer(),
+		NestedFields:                 (*cribl.OutputSplunkLbNestedFields)(o.NestedFields.ValueStringPointer()),
+		ThrottleRatePerSec:           o.ThrottleRatePerSec.ValueStringPointer(),
+		ConnectionTimeout:            o.ConnectionTimeout.ValueFloat32Pointer(),
+		WriteTimeout:                 o.WriteTimeout.ValueFloat32Pointer(),
+		EnableMultiMetrics:           o.EnableMultiMetrics.ValueBoolPointer(),
+		EnableACK:                    (*cribl.OutputSplunkLbEnableACK)(o.EnableACK.ValueBoolPointer()),
+		LogFailedRequests:            o.LogFailedRequests.ValueBoolPointer(),
+		MaxS2Sversion:                (*cribl.OutputSplunkLbMaxS2Sversion)(o.MaxS2SVersion.ValueStringPointer()),
+		MaxFailedHealthChecks:        o.MaxFailedHealthChecks.ValueFloat32Pointer(),
+		SenderUnhealthyTimeAllowance: o.SenderUnhealthyTimeAllowance.ValueFloat32Pointer(),
+		OnBackpressure:               (*cribl.OutputSplunkLbOnBackpressure)(o.OnBackpressure.ValueStringPointer()),
+		PqMode:                       (*cribl.OutputSplunkLbPqMode)(pq.Mode.ValueStringPointer()),
+		PqMaxFileSize:                pq.MaxFileSize.ValueStringPointer(),
+		PqMaxSize:                    pq.MaxSize.ValueStringPointer(),
+		PqPath:                       pq.Path.ValueStringPointer(),
+		PqCompress:                   (*cribl.OutputSplunkLbPqCompress)(pq.Compress.ValueStringPointer()),
+		PqOnBackpressure:             (*cribl.OutputSplunkLbPqOnBackpressure)(pq.OnBackpressure.ValueStringPointer()),
+	}
+
+	// hosts is required by the api even when indexers are discovered
+	out.Hosts = []cribl.OutputSplunkLbHost{}
+	for _, host := range o.Hosts {
+		out.Hosts = append(out.Hosts, cribl.OutputSplunkLbHost{
+			Host:       host.Host.ValueString(),
+			Port:       host.Port.ValueFloat32(),
+			Servername: host.Servername.ValueStringPointer(),
+			Tls:        (*cribl.OutputSplunkLbHostsTls)(host.TLS.ValueStringPointer()),
+			Weight:     host.Weight.ValueFloat32Pointer(),
+		})
+	}
+
+	out.IndexerDiscovery = lo.ToPtr(cribl.OutputSplunkLbIndexerDiscovery(o.IndexerDiscovery != nil))
+	if d := o.IndexerDiscovery; d != nil {
+		out.IndexerDiscoveryConfigs = &cribl.OutputSplunkLbIndexerDiscoveryConfigs{
+			MasterUri:          d.MasterURI.ValueString(),
+			Site:               d.Site.ValueString(),
+			RefreshIntervalSec: d.RefreshIntervalSec.ValueFloat32(),
+			RejectUnauthorized: d.RejectUnauthorized.ValueBoolPointer(),
+			AuthType:           (*cribl.OutputSplunkLbIndexerDiscoveryConfigsAuthType)(d.AuthType.ValueStringPointer()),
+			AuthToken:          d.AuthToken.ValueStringPointer(),
+			TextSecret:         d.TextSecret.ValueStringPointer(),
+		}
+	}
+	return out
+}
+
+func (o *OutputSplunkLb) FromCriblOutputSplunkLb(model cribl.OutputSplunkLb) {
+	o.ID = types.StringPointerValue(model.Id)
+	o.Description = types.StringPointerValue(model.Description)
+	o.Environment = types.StringPointerValue(model.Environment)
+	o.Pipeline = types.StringPointerValue(model.Pipeline)
+	o.StreamTags = fromStringSlice(model.Streamtags)
+	o.SystemFields = fromStringSlice(model.SystemFields)
+	o.AuthType = types.StringPointerValue((*string)(model.AuthType))
+	o.AuthToken = types.StringPointerValue(model.AuthToken)
+	o.TextSecret = types.StringPointerValue(model.TextSecret)
+	o.TLS = fromCriblOutputTLS(model.Tls)
+	o.DNSResolvePeriodSec = types.Float32PointerValue(model.DnsResolvePeriodSec)
+	o.LoadBalanceStatsPeriodSec = types.Float32PointerValue(model.LoadBalanceStatsPeriodSec)
+	o.MaxConcurrentSenders = types.Float32PointerValue(model.MaxConcurrentSenders)
+	o.ExcludeSelf = types.BoolPointerValue(model.ExcludeSelf)
+	o.NestedFields = types.StringPointerValue((*string)(model.NestedFields))
+	o.ThrottleRatePerSec = types.StringPointerValue(model.ThrottleRatePerSec)
+	o.ConnectionTimeout = types.Float32PointerValue(model.ConnectionTimeout)
+	o.WriteTimeout = types.Float32PointerValue(model.WriteTimeout)
+	o.EnableMultiMetrics = types.BoolPointerValue(model.EnableMultiMetrics)
+	o.EnableACK = types.BoolPointerValue((*bool)(model.EnableACK))
+	o.LogFailedRequests = types.BoolPointerValue(model.LogFailedRequests)
+	o.MaxS2SVersion = types.StringPointerValue((*string)(model.MaxS2Sversion))
+	o.MaxFailedHealthChecks = types.Float32PointerValue(model.MaxFailedHealthChecks)
+	o.SenderUnhealthyTimeAllowance = types.Float32PointerValue(model.SenderUnhealthyTimeAllowance)
+	o.OnBackpressure = types.StringPointerValue((*string)(model.OnBackpressure))
+	o.PQ = fromCriblOutputPQ(
+		(*string)(model.PqMode),
+		model.PqMaxFileSize,
+		model.PqMaxSize,
+		model.PqPath,
+		(*string)(model.PqCompress),
+		(*string)(model.PqOnBackpressure),
+	)
+
+	// cribl returns an empty list of hosts when indexer discovery is used,
+	// so an empty list is only kept when one is configured
+	if o.Hosts != nil {
+		o.Hosts = []SplunkLbHost{}
+	}
+	for _, host := range model.Hosts {
+		o.Hosts = append(o.Hosts, SplunkLbHost{
+			Host:       types.StringValue(host.Host),
+			Port:       types.Float32Value(host.Port),
+			Servername: types.StringPointerValue(host.Servername),
+			TLS:        types.StringPointerValue((*string)(host.Tls)),
+			Weight:     types.Float32PointerValue(host.Weight),
+		})
+	}
+
+	o.IndexerDiscovery = nil
+	if lo.FromPtr(model.IndexerDiscovery) && model.IndexerDiscoveryConfigs != nil {
+		d := model.IndexerDiscoveryConfigs
+		o.IndexerDiscovery = &SplunkLbIndexerDiscovery{
+			MasterURI:          types.StringValue(d.MasterUri),
+			Site:               types.StringValue(d.Site),
+			RefreshIntervalSec: types.Float32Value(d.RefreshIntervalSec),
+			RejectUnauthorized: types.BoolPointerValue(d.RejectUnauthorized),
+			AuthType:           types.StringPointerValue((*string)(d.AuthType)),
+			AuthToken:          types.StringPointerValue(d.AuthToken),
+			TextSecret:         types.StringPointerValue(d.TextSecret),
+		}
+	}
+}
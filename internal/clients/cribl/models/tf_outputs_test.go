@@ -0,0 +1,108 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOutputS3RoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		in   OutputS3
+	}{
+		{
+			name: "required attributes only",
+			in: OutputS3{
+				ID:           types.StringValue("out_s3"),
+				Bucket:       types.StringValue("`logs-${C.env.ENV}`"),
+				StagePath:    types.StringValue("$CRIBL_HOME/state/outputs/staging"),
+				StreamTags:   types.ListNull(types.StringType),
+				SystemFields: types.ListNull(types.StringType),
+			},
+		},
+		{
+			name: "manual aws auth with assume role",
+			in: OutputS3{
+				ID:                 types.StringValue("out_s3"),
+				Type:               types.StringValue("s3"),
+				Bucket:             types.StringValue("logs"),
+				StagePath:          types.StringValue("/tmp/staging"),
+				Region:             types.StringValue("us-east-1"),
+				Endpoint:           types.StringValue("https://s3.us-east-1.amazonaws.com"),
+				SignatureVersion:   types.StringValue("v4"),
+				ReuseConnections:   types.BoolValue(true),
+				RejectUnauthorized: types.BoolValue(false),
+				StreamTags:         types.ListNull(types.StringType),
+				SystemFields:       types.ListNull(types.StringType),
+				AWSAuth: AWSAuth{
+					AwsAuthenticationMethod: types.StringValue("manual"),
+					AwsApiKey:               types.StringValue("AKIAEXAMPLE"),
+					AwsSecretKey:            types.StringValue("secret"),
+					EnableAssumeRole:        types.BoolValue(true),
+					AssumeRoleArn:           types.StringValue("arn:aws:iam::123456789012:role/cribl"),
+					AssumeRoleExternalId:    types.StringValue("external"),
+					DurationSeconds:         types.Float32Value(3600),
+				},
+			},
+		},
+		{
+			name: "parquet with key value metadata",
+			in: OutputS3{
+				ID:                     types.StringValue("out_s3"),
+				Bucket:                 types.StringValue("logs"),
+				StagePath:              types.StringValue("/tmp/staging"),
+				DestPath:               types.StringValue("archive"),
+				PartitionExpr:          types.StringValue("`${host}/${sourcetype}`"),
+				Format:                 types.StringValue("parquet"),
+				Compress:               types.StringValue("gzip"),
+				CompressionLevel:       types.StringValue("best_speed"),
+				MaxFileSizeMB:          types.Float32Value(64),
+				AutomaticSchema:        types.BoolValue(true),
+				ParquetVersion:         types.StringValue("PARQUET_2_6"),
+				ParquetDataPageVersion: types.StringValue("DATA_PAGE_V2"),
+				ParquetPageSize:        types.StringValue("1MB"),
+				ParquetRowGroupLength:  types.Float32Value(10000),
+				EnableStatistics:       types.BoolValue(true),
+				StreamTags:             types.ListNull(types.StringType),
+				SystemFields:           types.ListNull(types.StringType),
+				KeyValueMetadata: []KeyValue{
+					{Key: types.StringValue("team"), Value: types.StringValue("platform")},
+				},
+			},
+		},
+		{
+			name: "stream tags and empty lists",
+			in: OutputS3{
+				ID:               types.StringValue("out_s3"),
+				Bucket:           types.StringValue("logs"),
+				StagePath:        types.StringValue("/tmp/staging"),
+				Description:      types.StringValue("archive to s3"),
+				Pipeline:         types.StringValue("passthru"),
+				StreamTags:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("archive")}),
+				SystemFields:     types.ListValueMust(types.StringType, []attr.Value{}),
+				KeyValueMetadata: []KeyValue{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the type and the AWS keys are left as they are in state
+			got := OutputS3{
+				Type: tc.in.Type,
+				AWSAuth: AWSAuth{
+					AwsApiKey:    tc.in.AwsApiKey,
+					AwsSecretKey: tc.in.AwsSecretKey,
+				},
+			}
+			got.FromCriblOutputS3(tc.in.ToCriblOutputS3())
+
+			if !reflect.DeepEqual(got, tc.in) {
+				t.Errorf("round trip changed the model\nwant %+v\ngot  %+v", tc.in, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type OutputWebhook struct {
+	ID                        types.String      `tfsdk:"id"`
+	Description               types.String      `tfsdk:"description"`
+	Environment               types.String      `tfsdk:"environment"`
+	Pipeline                  types.String      `tfsdk:"pipeline"`
+	StreamTags                types.List        `tfsdk:"stream_tags"`
+	SystemFields              types.List        `tfsdk:"system_fields"`
+	URL                       types.String      `tfsdk:"url"`
+	URLs                      []LoadBalancedURL `tfsdk:"urls"`
+	ExcludeSelf               types.Bool        `tfsdk:"exclude_self"`
+	DNSResolvePeriodSec       types.Float32     `tfsdk:"dns_resolve_period_sec"`
+	LoadBalanceStatsPeriodSec types.Float32     `tfsdk:"load_balance_stats_period_sec"`
+	UseRoundRobinDNS          types.Bool        `tfsdk:"use_round_robin_dns"`
+	Method                    types.String      `tfsdk:"method"`
+	Format                    types.String      `tfsdk:"format"`
+	KeepAlive                 types.Bool        `tfsdk:"keep_alive"`
+	CustomSourceExpression    types.String      `tfsdk:"custom_source_expression"`
+	CustomDropWhenNull        types.Bool        `tfsdk:"custom_drop_when_null"`
+	CustomEventDelimiter      types.String      `tfsdk:"custom_event_delimiter"`
+	CustomContentType         types.String      `tfsdk:"custom_content_type"`
+	CustomPayloadExpression   types.String      `tfsdk:"custom_payload_expression"`
+	AdvancedContentType       types.String      `tfsdk:"advanced_content_type"`
+	FormatEventCode           types.String      `tfsdk:"format_event_code"`
+	FormatPayloadCode         types.String      `tfsdk:"format_payload_code"`
+	HTTPAuth
+	ExtraHTTPHeaders              []ExtraHTTPField       `tfsdk:"extra_http_headers"`
+	SafeHeaders                   types.List             `tfsdk:"safe_headers"`
+	FailedRequestLoggingMode      types.String           `tfsdk:"failed_request_logging_mode"`
+	Compress                      types.Bool             `tfsdk:"compress"`
+	Concurrency                   types.Float32          `tfsdk:"concurrency"`
+	MaxPayloadSizeKB              types.Float32          `tfsdk:"max_payload_size_kb"`
+	MaxPayloadEvents              types.Float32          `tfsdk:"max_payload_events"`
+	FlushPeriodSec                types.Float32          `tfsdk:"flush_period_sec"`
+	TimeoutSec                    types.Float32          `tfsdk:"timeout_sec"`
+	TotalMemoryLimitKB            types.Float32          `tfsdk:"total_memory_limit_kb"`
+	RejectUnauthorized            types.Bool             `tfsdk:"reject_unauthorized"`
+	ResponseRetrySettings         []ResponseRetrySetting `tfsdk:"response_retry_settings"`
+	TimeoutRetrySettings          *TimeoutRetrySettings  `tfsdk:"timeout_retry_settings"`
+	ResponseHonorRetryAfterHeader types.Bool             `tfsdk:"response_honor_retry_after_header"`
+	TLS                           *OutputTLS             `tfsdk:"tls"`
+	OnBackpressure                types.String           `tfsdk:"on_backpressure"`
+	PQ                            *OutputPQ              `tfsdk:"pq"`
+}
+
+func (o *OutputWebhook) ToCriblOutputWebhook() cribl.OutputWebhook {
+	pq := lo.FromPtr(o.PQ)
+	return cribl.OutputWebhook{
+		Id:                            o.ID.ValueString(),
+		Type:                          cribl.OutputWebhookType("webhook"),
+		Description:                   o.Description.ValueStringPointer(),
+		Environment:                   o.Environment.ValueStringPointer(),
+		Pipeline:                      o.Pipeline.ValueStringPointer(),
+		Streamtags:                    toStringSlice(o.StreamTags),
+		SystemFields:                  toStringSlice(o.SystemFields),
+		Url:                           o.URL.ValueStringPointer(),
+		Urls:                          toCriblLoadBalancedURLs(o.URLs),
+		LoadBalanced:                  lo.ToPtr(cribl.OutputWebhookLoadBalanced(len(o.URLs) > 0)),
+		ExcludeSelf:                   o.ExcludeSelf.ValueBoolPointer(),
+		DnsResolvePeriodSec:           o.DNSResolvePeriodSec.ValueFloat32Pointer(),
+		LoadBalanceStatsPeriodSec:     o.LoadBalanceStatsPeriodSec.ValueFloat32Pointer(),
+		UseRoundRobinDns:              o.UseRoundRobinDNS.ValueBoolPointer(),
+		Method:                        (*cribl.OutputWebhookMethod)(o.Method.ValueStringPointer()),
+		Format:                        (*cribl.OutputWebhookFormat)(o.Format.ValueStringPointer()),
+		KeepAlive:                     o.KeepAlive.ValueBoolPointer(),
+		CustomSourceExpression:        o.CustomSourceExpression.ValueStringPointer(),
+		CustomDropWhenNull:            o.CustomDropWhenNull.ValueBoolPointer(),
+		CustomEventDelimiter:          o.CustomEventDelimiter.ValueStringPointer(),
+		CustomContentType:             o.CustomContentType.ValueStringPointer(),
+		CustomPayloadExpression:       o.CustomPayloadExpression.ValueStringPointer(),
+		AdvancedContentType:           o.AdvancedContentType.ValueStringPointer(),
+		FormatEventCode:               o.FormatEventCode.ValueStringPointer(),
+		FormatPayloadCode:             o.FormatPayloadCode.ValueStringPointer(),
+		AuthType:                      (*cribl.OutputWebhookAuthType)(o.AuthType.ValueStringPointer()),
+		Username:                      o.Username.ValueStringPointer(),
+		Password:                      o.Password.ValueStringPointer(),
+		Token:                         o.Token.ValueStringPointer(),
+		CredentialsSecret:             o.CredentialsSecret.ValueStringPointer(),
+		TextSecret:                    o.TextSecret.ValueStringPointer(),
+		LoginUrl:                      o.LoginURL.ValueStringPointer(),
+		SecretParamName:               o.SecretParamName.ValueStringPointer(),
+		Secret:                        o.Secret.ValueStringPointer(),
+		TokenAttributeName:            o.TokenAttributeName.ValueStringPointer(),
+		AuthHeaderExpr:                o.AuthHeaderExpr.ValueStringPointer(),
+		TokenTimeoutSecs:              o.TokenTimeoutSecs.ValueFloat32Pointer(),
+		OauthParams:                   toCriblOauthParams(o.OauthParams),
+		OauthHeaders:                  toCriblOauthHeaders(o.OauthHeaders),
+		ExtraHttpHeaders:              toCriblExtraHTTPHeaders(o.ExtraHTTPHeaders),
+		SafeHeaders:                   toStringSlice(o.SafeHeaders),
+		FailedRequestLoggingMode:      (*cribl.OutputWebhookFailedRequestLoggingMode)(o.FailedRequestLoggingMode.ValueStringPointer()),
+		Compress:                      o.Compress.ValueBoolPointer(),
+		Concurrency:                   o.Concurrency.ValueFloat32Pointer(),
+		MaxPayloadSizeKB:              o.MaxPayloadSizeKB.ValueFloat32Pointer(),
+		MaxPayloadEvents:              o.MaxPayloadEvents.ValueFloat32Pointer(),
+		FlushPeriodSec:                o.FlushPeriodSec.ValueFloat32Pointer(),
+		TimeoutSec:                    o.TimeoutSec.ValueFloat32Pointer(),
+		TotalMemoryLimitKB:            o.TotalMemoryLimitKB.ValueFloat32Pointer(),
+		RejectUnauthorized:            o.RejectUnauthorized.ValueBoolPointer(),
+		ResponseRetrySettings:         toCriblResponseRetrySettings(o.ResponseRetrySettings),
+		TimeoutRetrySettings:          o.TimeoutRetrySettings.toCribl(),
+		ResponseHonorRetryAfterHeader: o.ResponseHonorRetryAfterHeader.ValueBoolPointer(),
+		Tls:                           o.TLS.toCribl(),
+		OnBackpressure:                (*cribl.OutputWebhookOnBackpressure)(o.OnBackpressure.ValueStringPointer()),
+		PqMode:                        (*cribl.OutputWebhookPqMode)(pq.Mode.ValueStringPointer()),
+		PqMaxFileSize:                 pq.MaxFileSize.ValueStringPointer(),
+		PqMaxSize:                     pq.MaxSize.ValueStringPointer(),
+		PqPath:                        pq.Path.ValueStringPointer(),
+		PqCompress:                    (*cribl.OutputWebhookPqCompress)(pq.Compress.ValueStringPointer()),
+		PqOnBackpressure:              (*cribl.OutputWebhookPqOnBackpressure)(pq.OnBackpressure.ValueStringPointer()),
+	}
+}
+
+func (o *OutputWebhook) FromCriblOutputWebhook(model cribl.OutputWebhook) {
+	o.ID = types.StringValue(model.Id)
+	o.Description = types.StringPointerValue(model.Description)
+	o.Environment = types.StringPointerValue(model.Environment)
+	o.Pipeline = types.StringPointerValue(model.Pipeline)
+	o.StreamTags = fromStringSlice(model.Streamtags)
+	o.SystemFields = fromStringSlice(model.SystemFields)
+
+	// same as elastic, only the active one of url and urls is managed
+	o.URL = types.StringNull()
+	o.URLs = nil
+	if lo.FromPtr(model.LoadBalanced) {
+		o.URLs = fromCriblLoadBalancedURLs(model.Urls)
+	} else {
+		o.URL = types.StringPointerValue(model.Url)
+	}
+
+	o.ExcludeSelf = types.BoolPointerValue(model.ExcludeSelf)
+	o.DNSResolvePeriodSec = types.Float32PointerValue(model.DnsResolvePeriodSec)
+	o.LoadBalanceStatsPeriodSec = types.Float32PointerValue(model.LoadBalanceStatsPeriodSec)
+	o.UseRoundRobinDNS = types.BoolPointerValue(model.UseRoundRobinDns)
+	o.Method = types.StringPointerValue((*string)(model.Method))
+	o.Format = types.StringPointerValue((*string)(model.Format))
+	o.KeepAlive = types.BoolPointerValue(model.KeepAlive)
+	o.CustomSourceExpression = types.StringPointerValue(model.CustomSourceExpression)
+	o.CustomDropWhenNull = types.BoolPointerValue(model.CustomDropWhenNull)
+	o.CustomEventDelimiter = types.StringPointerValue(model.CustomEventDelimiter)
+	o.CustomContentType = types.StringPointerValue(model.CustomContentType)
+	o.CustomPayloadExpression = types.StringPointerValue(model.CustomPayloadExpression)
+	o.AdvancedContentType = types.StringPointerValue(model.AdvancedContentType)
+	o.FormatEventCode = types.StringPointerValue(model.FormatEventCode)
+	o.FormatPayloadCode = types.StringPointerValue(model.FormatPayloadCode)
+	o.AuthType = types.StringPointerValue((*string)(model.AuthType))
+	o.Username = types.StringPointerValue(model.Username)
+	o.Password = types.StringPointerValue(model.Password)
+	o.Token = types.StringPointerValue(model.Token)
+	o.CredentialsSecret = types.StringPointerValue(model.CredentialsSecret)
+	o.TextSecret = types.StringPointerValue(model.TextSecret)
+	o.LoginURL = types.StringPointerValue(model.LoginUrl)
+	o.SecretParamName = types.StringPointerValue(model.SecretParamName)
+	o.Secret = types.StringPointerValue(model.Secret)
+	o.TokenAttributeName = types.StringPointerValue(model.TokenAttributeName)
+	o.AuthHeaderExpr = types.StringPointerValue(model.AuthHeaderExpr)
+	o.TokenTimeoutSecs = types.Float32PointerValue(model.TokenTimeoutSecs)
+	o.OauthParams = fromCriblOauthParams(model.OauthParams)
+	o.OauthHeaders = fromCriblOauthHeaders(model.OauthHeaders)
+	o.ExtraHTTPHeaders = fromCriblExtraHTTPHeaders(model.ExtraHttpHeaders)
+	o.SafeHeaders = fromStringSlice(model.SafeHeaders)
+	o.FailedRequestLoggingMode = types.StringPointerValue((*string)(model.FailedRequestLoggingMode))
+	o.Compress = types.BoolPointerValue(model.Compress)
+	o.Concurrency = types.Float32PointerValue(model.Concurrency)
+	o.MaxPayloadSizeKB = types.Float32PointerValue(model.MaxPayloadSizeKB)
+	o.MaxPayloadEvents = types.Float32PointerValue(model.MaxPayloadEvents)
+	o.FlushPeriodSec = types.Float32PointerValue(model.FlushPeriodSec)
+	o.TimeoutSec = types.Float32PointerValue(model.TimeoutSec)
+	o.TotalMemoryLimitKB = types.Float32PointerValue(model.TotalMemoryLimitKB)
+	o.RejectUnauthorized = types.BoolPointerValue(model.RejectUnauthorized)
+	o.ResponseRetrySettings = fromCriblResponseRetrySettings(model.ResponseRetrySettings)
+	o.TimeoutRetrySettings = fromCriblTimeoutRetrySettings(model.TimeoutRetrySettings)
+	o.ResponseHonorRetryAfterHeader = types.BoolPointerValue(model.ResponseHonorRetryAfterHeader)
+	o.TLS = fromCriblOutputTLS(model.Tls)
+	o.OnBackpressure = types.StringPointerValue((*string)(model.OnBackpressure))
+	o.PQ = fromCriblOutputPQ(
+		(*string)(model.PqMode),
+		model.PqMaxFileSize,
+		model.PqMaxSize,
+		model.PqPath,
+		(*string)(model.PqCompress),
+		(*string)(model.PqOnBackpressure),
+	)
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type Parser struct {
+	ID          types.String `tfsdk:"id"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.List   `tfsdk:"tags"`
+	Conf        types.String `tfsdk:"conf"`
+}
+
+var ParserTypes = []cribl.ParserLibEntryType{
+	cribl.ParserLibEntryTypeCsv,
+	cribl.ParserLibEntryTypeElff,
+	cribl.ParserLibEntryTypeClf,
+	cribl.ParserLibEntryTypeKvp,
+	cribl.ParserLibEntryTypeJson,
+	cribl.ParserLibEntryTypeDelim,
+	cribl.ParserLibEntryTypeRegex,
+	cribl.ParserLibEntryTypeGrok,
+}
+
+// ParserEntryKeys are the fields of a parser library entry that the resource
+// sets itself, conf can't contain them.
+var ParserEntryKeys = []string{"id", "type", "lib", "description", "tags"}
+
+func (p *Parser) ToCriblParserLibEntry() (cribl.ParserLibEntry, error) {
+	out := cribl.ParserLibEntry{
+		Id:          p.ID.ValueString(),
+		Type:        cribl.ParserLibEntryType(p.Type.ValueString()),
+		Lib:         lo.ToPtr("custom"),
+		Description: p.Description.ValueStringPointer(),
+		Tags:        toCriblTags(p.Tags),
+	}
+	if !p.Conf.IsNull() {
+		if err := json.Unmarshal([]byte(p.Conf.ValueString()), &out.AdditionalProperties); err != nil {
+			return out, fmt.Errorf("conf: %w", err)
+		}
+		for _, key := range ParserEntryKeys {
+			if _, ok := out.AdditionalProperties[key]; ok {
+				return out, fmt.Errorf("conf: %q is set by the parser entry itself", key)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (p *Parser) FromCriblParserLibEntry(entry cribl.ParserLibEntry) error {
+	p.ID = types.StringValue(entry.Id)
+	p.Type = types.StringValue(string(entry.Type))
+	p.Description = types.StringPointerValue(entry.Description)
+	p.Tags = fromCriblTags(entry.Tags)
+
+	// everything but the common fields is the parser's own configuration
+	if len(entry.AdditionalProperties) == 0 {
+		p.Conf = types.StringNull()
+		return nil
+	}
+	conf, err := json.Marshal(entry.AdditionalProperties)
+	if err != nil {
+		return fmt.Errorf("conf: %w", err)
+	}
+	// keep the configured formatting of conf as long as it still describes
+	// the same object
+	if !jsonEqual(p.Conf.ValueString(), string(conf)) {
+		p.Conf = types.StringValue(string(conf))
+	}
+	return nil
+}
@@ -1,11 +1,195 @@
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
 
 type Pipeline struct {
+	ID          types.String             `tfsdk:"id"`
+	Description types.String             `tfsdk:"description"`
+	TimeoutMS   types.Int64              `tfsdk:"timeout_ms"`
+	StreamTags  types.List               `tfsdk:"stream_tags"`
+	Tags        types.List               `tfsdk:"tags"`
+	Output      types.String             `tfsdk:"output"`
+	Functions   []PipelineFunction       `tfsdk:"functions"`
+	Groups      map[string]PipelineGroup `tfsdk:"groups"`
+}
+
+type PipelineGroup struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+}
+
+type PipelineFunction struct {
 	ID          types.String `tfsdk:"id"`
+	Filter      types.String `tfsdk:"filter"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	Final       types.Bool   `tfsdk:"final"`
 	Description types.String `tfsdk:"description"`
-	TimeoutMS   types.Int64  `tfsdk:"timeout_ms"`
-	Tags        types.List   `tfsdk:"tags"`
-	Output      types.String `tfsdk:"output"`
+	GroupID     types.String `tfsdk:"group_id"`
+	Conf        types.String `tfsdk:"conf"`
+
+	Eval         *EvalFunction         `tfsdk:"eval"`
+	Mask         *MaskFunction         `tfsdk:"mask"`
+	Drop         *DropFunction         `tfsdk:"drop"`
+	Sampling     *SamplingFunction     `tfsdk:"sampling"`
+	Lookup       *LookupFunction       `tfsdk:"lookup"`
+	RegexExtract *RegexExtractFunction `tfsdk:"regex_extract"`
+	Parser       *ParserFunction       `tfsdk:"parser"`
+	Rename       *RenameFunction       `tfsdk:"rename"`
+	Serialize    *SerializeFunction    `tfsdk:"serialize"`
+	Aggregation  *AggregationFunction  `tfsdk:"aggregation"`
+	Suppress     *SuppressFunction     `tfsdk:"suppress"`
+}
+
+func (p *Pipeline) ToCriblPipeline() (cribl.Pipeline, error) {
+	out := cribl.Pipeline{
+		Id: p.ID.ValueString(),
+		Conf: cribl.PipelineConf{
+			AsyncFuncTimeout: lo.ToPtr(int(p.TimeoutMS.ValueInt64())),
+			Description:      p.Description.ValueStringPointer(),
+			Output:           p.Output.ValueStringPointer(),
+		},
+	}
+	// streamtags goes out as a list of strings or not at all, never as
+	// null. tags is the deprecated spelling of stream_tags.
+	if !p.StreamTags.IsNull() {
+		out.Conf.Streamtags = toStringSlice(p.StreamTags)
+	} else {
+		out.Conf.Streamtags = toStringSlice(p.Tags)
+	}
+	if p.Groups != nil {
+		groups := map[string]cribl.PipelineGroup{}
+		for id, group := range p.Groups {
+			groups[id] = cribl.PipelineGroup{
+				Name:        group.Name.ValueString(),
+				Description: group.Description.ValueStringPointer(),
+				Disabled:    group.Disabled.ValueBoolPointer(),
+			}
+		}
+		out.Conf.Groups = &groups
+	}
+	if p.Functions == nil {
+		return out, nil
+	}
+	functions := []cribl.PipelineFunctionConf{}
+	for i, function := range p.Functions {
+		id := function.ID.ValueString()
+		conf := map[string]interface{}{}
+		if !function.Conf.IsNull() {
+			if err := json.Unmarshal([]byte(function.Conf.ValueString()), &conf); err != nil {
+				return out, fmt.Errorf("functions[%d].conf: %w", i, err)
+			}
+		}
+		for typedID, typed := range function.typedFunctions() {
+			var err error
+			if conf, err = encodeConf(typed.toConf()); err != nil {
+				return out, fmt.Errorf("functions[%d]: %w", i, err)
+			}
+			id = typedID
+		}
+		functions = append(functions, cribl.PipelineFunctionConf{
+			Id:          id,
+			Filter:      function.Filter.ValueStringPointer(),
+			Disabled:    function.Disabled.ValueBoolPointer(),
+			Final:       function.Final.ValueBoolPointer(),
+			Description: function.Description.ValueStringPointer(),
+			GroupId:     function.GroupID.ValueStringPointer(),
+			Conf:        conf,
+		})
+	}
+	out.Conf.Functions = &functions
+	return out, nil
+}
+
+func (p *Pipeline) FromCriblPipeline(model cribl.Pipeline) error {
+	p.ID = types.StringValue(model.Id)
+	p.Description = types.StringPointerValue(model.Conf.Description)
+	p.Output = types.StringPointerValue(model.Conf.Output)
+	if model.Conf.AsyncFuncTimeout != nil {
+		p.TimeoutMS = types.Int64Value(int64(*model.Conf.AsyncFuncTimeout))
+	}
+	// an empty list doesn't replace null stream_tags, pipelines that never
+	// had any may come back with an empty one
+	streamTags := fromStringSlice(model.Conf.Streamtags)
+	if !p.Tags.IsNull() {
+		p.Tags = streamTags
+	} else if !p.StreamTags.IsNull() || len(streamTags.Elements()) > 0 {
+		p.StreamTags = streamTags
+	}
+
+	p.Groups = nil
+	if model.Conf.Groups != nil && len(*model.Conf.Groups) > 0 {
+		p.Groups = map[string]PipelineGroup{}
+		for id, group := range *model.Conf.Groups {
+			p.Groups[id] = PipelineGroup{
+				Name:        types.StringValue(group.Name),
+				Description: types.StringPointerValue(group.Description),
+				Disabled:    types.BoolPointerValue(group.Disabled),
+			}
+		}
+	}
+
+	prior := p.Functions
+	p.Functions = nil
+	for i, function := range lo.FromPtr(model.Conf.Functions) {
+		conf, err := json.Marshal(function.Conf)
+		if err != nil {
+			return fmt.Errorf("functions[%d].conf: %w", i, err)
+		}
+		out := PipelineFunction{
+			ID:          types.StringValue(function.Id),
+			Filter:      types.StringPointerValue(function.Filter),
+			Disabled:    types.BoolPointerValue(function.Disabled),
+			Final:       types.BoolPointerValue(function.Final),
+			Description: types.StringPointerValue(function.Description),
+			GroupID:     types.StringPointerValue(function.GroupId),
+			Conf:        types.StringValue(string(conf)),
+		}
+		// functions configured through a typed block are read back into
+		// that block, everything else round-trips through conf
+		if i < len(prior) && len(prior[i].typedFunctions()) > 0 {
+			if ok, err := out.setTypedFunction(function.Id, function.Conf); err != nil {
+				return fmt.Errorf("functions[%d].%s: %w", i, function.Id, err)
+			} else if ok {
+				if prior[i].ID.IsNull() {
+					out.ID = types.StringNull()
+				}
+				out.Conf = types.StringNull()
+				p.Functions = append(p.Functions, out)
+				continue
+			}
+		}
+		// keep the configured formatting of conf as long as it still
+		// describes the same object
+		if i < len(prior) {
+			if prior[i].Conf.IsNull() && len(function.Conf) == 0 {
+				out.Conf = prior[i].Conf
+			} else if jsonEqual(prior[i].Conf.ValueString(), string(conf)) {
+				out.Conf = prior[i].Conf
+			}
+		}
+		p.Functions = append(p.Functions, out)
+	}
+	return nil
+}
+
+// jsonEqual reports whether a and b are encodings of the same JSON value.
+func jsonEqual(a, b string) bool {
+	var left, right interface{}
+	if err := json.Unmarshal([]byte(a), &left); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &right); err != nil {
+		return false
+	}
+	l, _ := json.Marshal(left)
+	r, _ := json.Marshal(right)
+	return string(l) == string(r)
 }
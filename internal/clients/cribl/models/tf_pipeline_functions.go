@@ -0,0 +1,714 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+// Typed pipeline functions. Each type converts to and from the free-form
+// conf object cribl stores on PipelineFunctionConf, using a wire struct that
+// carries the cribl field names.
+
+type EvalFunction struct {
+	Add    []EvalField    `tfsdk:"add"`
+	Remove []types.String `tfsdk:"remove"`
+	Keep   []types.String `tfsdk:"keep"`
+}
+
+type EvalField struct {
+	Name     types.String `tfsdk:"name"`
+	Value    types.String `tfsdk:"value"`
+	Disabled types.Bool   `tfsdk:"disabled"`
+}
+
+type evalConf struct {
+	Add []struct {
+		Name     string `json:"name"`
+		Value    string `json:"value"`
+		Disabled *bool  `json:"disabled,omitempty"`
+	} `json:"add,omitempty"`
+	Remove []string `json:"remove,omitempty"`
+	Keep   []string `json:"keep,omitempty"`
+}
+
+func (f *EvalFunction) toConf() interface{} {
+	out := evalConf{
+		Remove: fromStringValues(f.Remove),
+		Keep:   fromStringValues(f.Keep),
+	}
+	for _, field := range f.Add {
+		out.Add = append(out.Add, struct {
+			Name     string `json:"name"`
+			Value    string `json:"value"`
+			Disabled *bool  `json:"disabled,omitempty"`
+		}{
+			Name:     field.Name.ValueString(),
+			Value:    field.Value.ValueString(),
+			Disabled: field.Disabled.ValueBoolPointer(),
+		})
+	}
+	return out
+}
+
+func (f *EvalFunction) fromConf(conf map[string]interface{}) error {
+	wire := evalConf{}
+	if err := decodeConf(conf, &wire); err != nil {
+		return err
+	}
+	f.Add = nil
+	for _, field := range wire.Add {
+		f.Add = append(f.Add, EvalField{
+			Name:     types.StringValue(field.Name),
+			Value:    types.StringValue(field.Value),
+			Disabled: types.BoolPointerValue(field.Disabled),
+		})
+	}
+	f.Remove = toStringValues(wire.Remove)
+	f.Keep = toStringValues(wire.Keep)
+	return nil
+}
+
+type MaskFunction struct {
+	Rules  []MaskRule     `tfsdk:"rules"`
+	Fields []types.String `tfsdk:"fields"`
+	Depth  types.Int64    `tfsdk:"depth"`
+}
+
+type MaskRule struct {
+	MatchRegex  types.String `tfsdk:"match_regex"`
+	ReplaceExpr types.String `tfsdk:"replace_expr"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+}
+
+type maskConf struct {
+	Rules []struct {
+		MatchRegex  string `json:"matchRegex"`
+		ReplaceExpr string `json:"replaceExpr"`
+		Disabled    *bool  `json:"disabled,omitempty"`
+	} `json:"rules"`
+	Fields []string `json:"fields,omitempty"`
+	Depth  *int64   `json:"depth,omitempty"`
+}
+
+func (f *MaskFunction) toConf() interface{} {
+	out := maskConf{
+		Fields: fromStringValues(f.Fields),
+		Depth:  f.Depth.ValueInt64Pointer(),
+	}
+	for _, rule := range f.Rules {
+		out.Rules = append(out.Rules, struct {
+			MatchRegex  string `json:"matchRegex"`
+			ReplaceExpr string `json:"replaceExpr"`
+			Disabled    *bool  `json:"disabled,omitempty"`
+		}{
+			MatchRegex:  rule.MatchRegex.ValueString(),
+			ReplaceExpr: rule.ReplaceExpr.ValueString(),
+			Disabled:    rule.Disabled.ValueBoolPointer(),
+		})
+	}
+	return out
+}
+
+func (f *MaskFunction) fromConf(conf map[string]interface{}) error {
+	wire := maskConf{}
+	if err := decodeConf(conf, &wire); err != nil {
+		return err
+	}
+	f.Rules = nil
+	for _, rule := range wire.Rules {
+		f.Rules = append(f.Rules, MaskRule{
+			MatchRegex:  types.StringValue(rule.MatchRegex),
+			ReplaceExpr: types.StringValue(rule.ReplaceExpr),
+			Disabled:    types.BoolPointerValue(rule.Disabled),
+		})
+	}
+	f.Fields = toStringValues(wire.Fields)
+	f.Depth = types.Int64PointerValue(wire.Depth)
+	return nil
+}
+
+// DropFunction has no settings, the function's filter selects what to drop.
+type DropFunction struct{}
+
+func (f *DropFunction) toConf() interface{} {
+	return struct{}{}
+}
+
+func (f *DropFunction) fromConf(conf map[string]interface{}) error {
+	return nil
+}
+
+type SamplingFunction struct {
+	Rules []SamplingRule `tfsdk:"rules"`
+}
+
+type SamplingRule struct {
+	Filter types.String `tfsdk:"filter"`
+	Rate   types.Int64  `tfsdk:"rate"`
+}
+
+type samplingConf struct {
+	Rules []struct {
+		Filter string `json:"filter"`
+		Rate   int64  `json:"rate"`
+	} `json:"rules"`
+}
+
+func (f *SamplingFunction) toConf() interface{} {
+	out := samplingConf{}
+	for _, rule := range f.Rules {
+		out.Rules = append(out.Rules, struct {
+			Filter string `json:"filter"`
+			Rate   int64  `json:"rate"`
+		}{
+			Filter: rule.Filter.ValueString(),
+			Rate:   rule.Rate.ValueInt64(),
+		})
+	}
+	return out
+}
+
+func (f *SamplingFunction) fromConf(conf map[string]interface{}) error {
+	wire := samplingConf{}
+	if err := decodeConf(conf, &wire); err != nil {
+		return err
+	}
+	f.Rules = nil
+	for _, rule := range wire.Rules {
+		f.Rules = append(f.Rules, SamplingRule{
+			Filter: types.StringValue(rule.Filter),
+			Rate:   types.Int64Value(rule.Rate),
+		})
+	}
+	return nil
+}
+
+type LookupFunction struct {
+	File            types.String     `tfsdk:"file"`
+	MatchMode       types.String     `tfsdk:"match_mode"`
+	MatchType       types.String     `tfsdk:"match_type"`
+	ReloadPeriodSec types.Int64      `tfsdk:"reload_period_sec"`
+	InFields        []LookupInField  `tfsdk:"in_fields"`
+	OutFields       []LookupOutField `tfsdk:"out_fields"`
+	AddToEvent      types.Bool       `tfsdk:"add_to_event"`
+	IgnoreCase      types.Bool       `tfsdk:"ignore_case"`
+}
+
+type LookupInField struct {
+	EventField  types.String `tfsdk:"event_field"`
+	LookupField types.String `tfsdk:"lookup_field"`
+}
+
+type LookupOutField struct {
+	LookupField  types.String `tfsdk:"lookup_field"`
+	EventField   types.String `tfsdk:"event_field"`
+	DefaultValue types.String `tfsdk:"default_value"`
+}
+
+type lookupConf struct {
+	File            string  `json:"file"`
+	MatchMode       *string `json:"matchMode,omitempty"`
+	MatchType       *string `json:"matchType,omitempty"`
+	ReloadPeriodSec *int64  `json:"reloadPeriodSec,omitempty"`
+	InFields        []struct {
+		EventField  string  `json:"eventField"`
+		LookupField *string `json:"lookupField,omitempty"`
+	} `json:"inFields"`
+	OutFields []struct {
+		LookupField  string  `json:"lookupField"`
+		EventField   *string `json:"eventField,omitempty"`
+		DefaultValue *string `json:"defaultValue,omitempty"`
+	} `json:"outFields,omitempty"`
+	AddToEvent *bool `json:"addToEvent,omitempty"`
+	IgnoreCase *bool `json:"ignoreCase,omitempty"`
+}
+
+func (f *LookupFunction) toConf() interface{} {
+	out := lookupConf{
+		File:            f.File.ValueString(),
+		MatchMode:       f.MatchMode.ValueStringPointer(),
+		MatchType:       f.MatchType.ValueStringPointer(),
+		ReloadPeriodSec: f.ReloadPeriodSec.ValueInt64Pointer(),
+		AddToEvent:      f.AddToEvent.ValueBoolPointer(),
+		IgnoreCase:      f.IgnoreCase.ValueBoolPointer(),
+	}
+	for _, field := range f.InFields {
+		out.InFields = append(out.InFields, struct {
+			EventField  string  `json:"eventField"`
+			LookupField *string `json:"lookupField,omitempty"`
+		}{
+			EventField:  field.EventField.ValueString(),
+			LookupField: field.LookupField.ValueStringPointer(),
+		})
+	}
+	for _, field := range f.OutFields {
+		out.OutFields = append(out.OutFields, struct {
+			LookupField  string  `json:"lookupField"`
+			EventField   *string `json:"eventField,omitempty"`
+			DefaultValue *string `json:"defaultValue,omitempty"`
+		}{
+			LookupField:  field.LookupField.ValueString(),
+			EventField:   field.EventField.ValueStringPointer(),
+			DefaultValue: field.DefaultValue.ValueStringPointer(),
+		})
+	}
+	return out
+}
+
+func (f *LookupFunction) fromConf(conf map[string]interface{}) error {
+	wire := lookupConf{}
+	if err := decodeConf(conf, &wire); err != nil {
+		return err
+	}
+	f.File = types.StringValue(wire.File)
+	f.MatchMode = types.StringPointerValue(wire.MatchMode)
+	f.MatchType = types.StringPointerValue(wire.MatchType)
+	f.ReloadPeriodSec = types.Int64PointerValue(wire.ReloadPeriodSec)
+	f.AddToEvent = types.BoolPointerValue(wire.AddToEvent)
+	f.IgnoreCase = types.BoolPointerValue(wire.IgnoreCase)
+	f.InFields = nil
+	for _, field := range wire.InFields {
+		f.InFields = append(f.InFields, LookupInField{
+			EventField:  types.StringValue(field.EventField),
+			LookupField: types.StringPointerValue(field.LookupField),
+		})
+	}
+	f.OutFields = nil
+	for _, field := range wire.OutFields {
+		f.OutFields = append(f.OutFields, LookupOutField{
+			LookupField:  types.StringValue(field.LookupField),
+			EventField:   types.StringPointerValue(field.EventField),
+			DefaultValue: types.StringPointerValue(field.DefaultValue),
+		})
+	}
+	return nil
+}
+
+type RegexExtractFunction struct {
+	Regex               types.String   `tfsdk:"regex"`
+	RegexList           []types.String `tfsdk:"regex_list"`
+	Source              types.String   `tfsdk:"source"`
+	Iterations          types.Int64    `tfsdk:"iterations"`
+	FieldNameExpression types.String   `tfsdk:"field_name_expression"`
+	Overwrite           types.Bool     `tfsdk:"overwrite"`
+}
+
+type regexExtractConf struct {
+	Regex     string `json:"regex"`
+	RegexList []struct {
+		Regex string `json:"regex"`
+	} `json:"regexList,omitempty"`
+	Source              *string `json:"source,omitempty"`
+	Iterations          *int64  `json:"iterations,omitempty"`
+	FieldNameExpression *string `json:"fieldNameExpression,omitempty"`
+	Overwrite           *bool   `json:"overwrite,omitempty"`
+}
+
+func (f *RegexExtractFunction) toConf() interface{} {
+	out := regexExtractConf{
+		Regex:               f.Regex.ValueString(),
+		Source:              f.Source.ValueStringPointer(),
+		Iterations:          f.Iterations.ValueInt64Pointer(),
+		FieldNameExpression: f.FieldNameExpression.ValueStringPointer(),
+		Overwrite:           f.Overwrite.ValueBoolPointer(),
+	}
+	for _, regex := range fromStringValues(f.RegexList) {
+		out.RegexList = append(out.RegexList, struct {
+			Regex string `json:"regex"`
+		}{Regex: regex})
+	}
+	return out
+}
+
+func (f *RegexExtractFunction) fromConf(conf map[string]interface{}) error {
+	wire := regexExtractConf{}
+	if err := decodeConf(conf, &wire); err != nil {
+		return err
+	}
+	f.Regex = types.StringValue(wire.Regex)
+	f.RegexList = nil
+	for _, regex := range wire.RegexList {
+		f.RegexList = append(f.RegexList, types.StringValue(regex.Regex))
+	}
+	f.Source = types.StringPointerValue(wire.Source)
+	f.Iterations = types.Int64PointerValue(wire.Iterations)
+	f.FieldNameExpression = types.StringPointerValue(wire.FieldNameExpression)
+	f.Overwrite = types.BoolPointerValue(wire.Overwrite)
+	return nil
+}
+
+type ParserFunction struct {
+	Mode            types.String   `tfsdk:"mode"`
+	Type            types.String   `tfsdk:"type"`
+	SrcField        types.String   `tfsdk:"src_field"`
+	DstField        types.String   `tfsdk:"dst_field"`
+	Fields          []types.String `tfsdk:"fields"`
+	Keep            []types.String `tfsdk:"keep"`
+	Remove          []types.String `tfsdk:"remove"`
+	FieldFilterExpr types.String   `tfsdk:"field_filter_expr"`
+}
+
+type parserConf struct {
+	Mode            string   `json:"mode"`
+	Type            string   `json:"type"`
+	SrcField        *string  `json:"srcField,omitempty"`
+	DstField        *string  `json:"dstField,omitempty"`
+	Fields          []string `json:"fields,omitempty"`
+	Keep            []string `json:"keep,omitempty"`
+	Remove          []string `json:"remove,omitempty"`
+	FieldFilterExpr *string  `json:"fieldFilterExpr,omitempty"`
+}
+
+func (f *ParserFunction) toConf() interface{} {
+	return parserConf{
+		Mode:            f.Mode.ValueString(),
+		Type:            f.Type.ValueString(),
+		SrcField:        f.SrcField.ValueStringPointer(),
+		DstField:        f.DstField.ValueStringPointer(),
+		Fields:          fromStringValues(f.Fields),
+		Keep:            fromStringValues(f.Keep),
+		Remove:          fromStringValues(f.Remove),
+		FieldFilterExpr: f.FieldFilterExpr.ValueStringPointer(),
+	}
+}
+
+func (f *ParserFunction) fromConf(conf map[string]interface{}) error {
+	wire := parserConf{}
+	if err := decodeConf(conf, &wire); err != nil {
+		return err
+	}
+	f.Mode = types.StringValue(wire.Mode)
+	f.Type = types.StringValue(wire.Type)
+	f.SrcField = types.StringPointerValue(wire.SrcField)
+	f.DstField = types.StringPointerValue(wire.DstField)
+	f.Fields = toStringValues(wire.Fields)
+	f.Keep = toStringValues(wire.Keep)
+	f.Remove = toStringValues(wire.Remove)
+	f.FieldFilterExpr = types.StringPointerValue(wire.FieldFilterExpr)
+	return nil
+}
+
+type RenameFunction struct {
+	Rename        []RenameField  `tfsdk:"rename"`
+	RenameExpr    types.String   `tfsdk:"rename_expr"`
+	BaseFields    []types.String `tfsdk:"base_fields"`
+	WildcardDepth types.Int64    `tfsdk:"wildcard_depth"`
+}
+
+type RenameField struct {
+	CurrentName types.String `tfsdk:"current_name"`
+	NewName     types.String `tfsdk:"new_name"`
+}
+
+type renameConf struct {
+	Rename []struct {
+		CurrentName string `json:"currentName"`
+		NewName     string `json:"newName"`
+	} `json:"rename,omitempty"`
+	RenameExpr    *string  `json:"renameExpr,omitempty"`
+	BaseFields    []string `json:"baseFields,omitempty"`
+	WildcardDepth *int64   `json:"wildcardDepth,omitempty"`
+}
+
+func (f *RenameFunction) toConf() interface{} {
+	out := renameConf{
+		RenameExpr:    f.RenameExpr.ValueStringPointer(),
+		BaseFields:    fromStringValues(f.BaseFields),
+		WildcardDepth: f.WildcardDepth.ValueInt64Pointer(),
+	}
+	for _, field := range f.Rename {
+		out.Rename = append(out.Rename, struct {
+			CurrentName string `json:"currentName"`
+			NewName     string `json:"newName"`
+		}{
+			CurrentName: field.CurrentName.ValueString(),
+			NewName:     field.NewName.ValueString(),
+		})
+	}
+	return out
+}
+
+func (f *RenameFunction) fromConf(conf map[string]interface{}) error {
+	wire := renameConf{}
+	if err := decodeConf(conf, &wire); err != nil {
+		return err
+	}
+	f.Rename = nil
+	for _, field := range wire.Rename {
+		f.Rename = append(f.Rename, RenameField{
+			CurrentName: types.StringValue(field.CurrentName),
+			NewName:     types.StringValue(field.NewName),
+		})
+	}
+	f.RenameExpr = types.StringPointerValue(wire.RenameExpr)
+	f.BaseFields = toStringValues(wire.BaseFields)
+	f.WildcardDepth = types.Int64PointerValue(wire.WildcardDepth)
+	return nil
+}
+
+type SerializeFunction struct {
+	Type     types.String   `tfsdk:"type"`
+	Fields   []types.String `tfsdk:"fields"`
+	SrcField types.String   `tfsdk:"src_field"`
+	DstField types.String   `tfsdk:"dst_field"`
+}
+
+type serializeConf struct {
+	Type     string   `json:"type"`
+	Fields   []string `json:"fields,omitempty"`
+	SrcField *string  `json:"srcField,omitempty"`
+	DstField *string  `json:"dstField,omitempty"`
+}
+
+func (f *SerializeFunction) toConf() interface{} {
+	return serializeConf{
+		Type:     f.Type.ValueString(),
+		Fields:   fromStringValues(f.Fields),
+		SrcField: f.SrcField.ValueStringPointer(),
+		DstField: f.DstField.ValueStringPointer(),
+	}
+}
+
+func (f *SerializeFunction) fromConf(conf map[string]interface{}) error {
+	wire := serializeConf{}
+	if err := decodeConf(conf, &wire); err != nil {
+		return err
+	}
+	f.Type = types.StringValue(wire.Type)
+	f.Fields = toStringValues(wire.Fields)
+	f.SrcField = types.StringPointerValue(wire.SrcField)
+	f.DstField = types.StringPointerValue(wire.DstField)
+	return nil
+}
+
+type AggregationFunction struct {
+	TimeWindow          types.String   `tfsdk:"time_window"`
+	Aggregations        []types.String `tfsdk:"aggregations"`
+	Groupbys            []types.String `tfsdk:"groupbys"`
+	Cumulative          types.Bool     `tfsdk:"cumulative"`
+	Prefix              types.String   `tfsdk:"prefix"`
+	Passthrough         types.Bool     `tfsdk:"passthrough"`
+	PreserveGroupBys    types.Bool     `tfsdk:"preserve_group_bys"`
+	SufficientStatsOnly types.Bool     `tfsdk:"sufficient_stats_only"`
+	FlushOnInputClose   types.Bool     `tfsdk:"flush_on_input_close"`
+}
+
+type aggregationConf struct {
+	TimeWindow          string   `json:"timeWindow"`
+	Aggregations        []string `json:"aggregations"`
+	Groupbys            []string `json:"groupbys,omitempty"`
+	Cumulative          *bool    `json:"cumulative,omitempty"`
+	Prefix              *string  `json:"prefix,omitempty"`
+	Passthrough         *bool    `json:"passthrough,omitempty"`
+	PreserveGroupBys    *bool    `json:"preserveGroupBys,omitempty"`
+	SufficientStatsOnly *bool    `json:"sufficientStatsOnly,omitempty"`
+	FlushOnInputClose   *bool    `json:"flushOnInputClose,omitempty"`
+}
+
+func (f *AggregationFunction) toConf() interface{} {
+	return aggregationConf{
+		TimeWindow:          f.TimeWindow.ValueString(),
+		Aggregations:        fromStringValues(f.Aggregations),
+		Groupbys:            fromStringValues(f.Groupbys),
+		Cumulative:          f.Cumulative.ValueBoolPointer(),
+		Prefix:              f.Prefix.ValueStringPointer(),
+		Passthrough:         f.Passthrough.ValueBoolPointer(),
+		PreserveGroupBys:    f.PreserveGroupBys.ValueBoolPointer(),
+		SufficientStatsOnly: f.SufficientStatsOnly.ValueBoolPointer(),
+		FlushOnInputClose:   f.FlushOnInputClose.ValueBoolPointer(),
+	}
+}
+
+func (f *AggregationFunction) fromConf(conf map[string]interface{}) error {
+	wire := aggregationConf{}
+	if err := decodeConf(conf, &wire); err != nil {
+		return err
+	}
+	f.TimeWindow = types.StringValue(wire.TimeWindow)
+	f.Aggregations = toStringValues(wire.Aggregations)
+	f.Groupbys = toStringValues(wire.Groupbys)
+	f.Cumulative = types.BoolPointerValue(wire.Cumulative)
+	f.Prefix = types.StringPointerValue(wire.Prefix)
+	f.Passthrough = types.BoolPointerValue(wire.Passthrough)
+	f.PreserveGroupBys = types.BoolPointerValue(wire.PreserveGroupBys)
+	f.SufficientStatsOnly = types.BoolPointerValue(wire.SufficientStatsOnly)
+	f.FlushOnInputClose = types.BoolPointerValue(wire.FlushOnInputClose)
+	return nil
+}
+
+type SuppressFunction struct {
+	KeyExpr                     types.String `tfsdk:"key_expr"`
+	Allow                       types.Int64  `tfsdk:"allow"`
+	SuppressPeriodSec           types.Int64  `tfsdk:"suppress_period_sec"`
+	DropEventsMode              types.Bool   `tfsdk:"drop_events_mode"`
+	MaxCacheSize                types.Int64  `tfsdk:"max_cache_size"`
+	CacheIdleTimeoutPeriods     types.Int64  `tfsdk:"cache_idle_timeout_periods"`
+	NumEventsIdleTimeoutTrigger types.Int64  `tfsdk:"num_events_idle_timeout_trigger"`
+}
+
+type suppressConf struct {
+	KeyExpr                     string `json:"keyExpr"`
+	Allow                       *int64 `json:"allow,omitempty"`
+	SuppressPeriodSec           *int64 `json:"suppressPeriodSec,omitempty"`
+	DropEventsMode              *bool  `json:"dropEventsMode,omitempty"`
+	MaxCacheSize                *int64 `json:"maxCacheSize,omitempty"`
+	CacheIdleTimeoutPeriods     *int64 `json:"cacheIdleTimeoutPeriods,omitempty"`
+	NumEventsIdleTimeoutTrigger *int64 `json:"numEventsIdleTimeoutTrigger,omitempty"`
+}
+
+func (f *SuppressFunction) toConf() interface{} {
+	return suppressConf{
+		KeyExpr:                     f.KeyExpr.ValueString(),
+		Allow:                       f.Allow.ValueInt64Pointer(),
+		SuppressPeriodSec:           f.SuppressPeriodSec.ValueInt64Pointer(),
+		DropEventsMode:              f.DropEventsMode.ValueBoolPointer(),
+		MaxCacheSize:                f.MaxCacheSize.ValueInt64Pointer(),
+		CacheIdleTimeoutPeriods:     f.CacheIdleTimeoutPeriods.ValueInt64Pointer(),
+		NumEventsIdleTimeoutTrigger: f.NumEventsIdleTimeoutTrigger.ValueInt64Pointer(),
+	}
+}
+
+func (f *SuppressFunction) fromConf(conf map[string]interface{}) error {
+	wire := suppressConf{}
+	if err := decodeConf(conf, &wire); err != nil {
+		return err
+	}
+	f.KeyExpr = types.StringValue(wire.KeyExpr)
+	f.Allow = types.Int64PointerValue(wire.Allow)
+	f.SuppressPeriodSec = types.Int64PointerValue(wire.SuppressPeriodSec)
+	f.DropEventsMode = types.BoolPointerValue(wire.DropEventsMode)
+	f.MaxCacheSize = types.Int64PointerValue(wire.MaxCacheSize)
+	f.CacheIdleTimeoutPeriods = types.Int64PointerValue(wire.CacheIdleTimeoutPeriods)
+	f.NumEventsIdleTimeoutTrigger = types.Int64PointerValue(wire.NumEventsIdleTimeoutTrigger)
+	return nil
+}
+
+type typedFunction interface {
+	toConf() interface{}
+	fromConf(conf map[string]interface{}) error
+}
+
+// TypedFunctionIDs returns the cribl function ids of the typed blocks set
+// on f.
+func (f *PipelineFunction) TypedFunctionIDs() []string {
+	return lo.Keys(f.typedFunctions())
+}
+
+func (f *PipelineFunction) typedFunctions() map[string]typedFunction {
+	out := map[string]typedFunction{}
+	if f.Eval != nil {
+		out["eval"] = f.Eval
+	}
+	if f.Mask != nil {
+		out["mask"] = f.Mask
+	}
+	if f.Drop != nil {
+		out["drop"] = f.Drop
+	}
+	if f.Sampling != nil {
+		out["sampling"] = f.Sampling
+	}
+	if f.Lookup != nil {
+		out["lookup"] = f.Lookup
+	}
+	if f.RegexExtract != nil {
+		out["regex_extract"] = f.RegexExtract
+	}
+	if f.Parser != nil {
+		out["serde"] = f.Parser
+	}
+	if f.Rename != nil {
+		out["rename"] = f.Rename
+	}
+	if f.Serialize != nil {
+		out["serialize"] = f.Serialize
+	}
+	if f.Aggregation != nil {
+		out["aggregation"] = f.Aggregation
+	}
+	if f.Suppress != nil {
+		out["suppress"] = f.Suppress
+	}
+	return out
+}
+
+// setTypedFunction fills the typed block for the cribl function id from
+// conf. It returns false when there is no typed block for id.
+func (f *PipelineFunction) setTypedFunction(id string, conf map[string]interface{}) (bool, error) {
+	var fn typedFunction
+	switch id {
+	case "eval":
+		f.Eval = &EvalFunction{}
+		fn = f.Eval
+	case "mask":
+		f.Mask = &MaskFunction{}
+		fn = f.Mask
+	case "drop":
+		f.Drop = &DropFunction{}
+		fn = f.Drop
+	case "sampling":
+		f.Sampling = &SamplingFunction{}
+		fn = f.Sampling
+	case "lookup":
+		f.Lookup = &LookupFunction{}
+		fn = f.Lookup
+	case "regex_extract":
+		f.RegexExtract = &RegexExtractFunction{}
+		fn = f.RegexExtract
+	case "serde":
+		f.Parser = &ParserFunction{}
+		fn = f.Parser
+	case "rename":
+		f.Rename = &RenameFunction{}
+		fn = f.Rename
+	case "serialize":
+		f.Serialize = &SerializeFunction{}
+		fn = f.Serialize
+	case "aggregation":
+		f.Aggregation = &AggregationFunction{}
+		fn = f.Aggregation
+	case "suppress":
+		f.Suppress = &SuppressFunction{}
+		fn = f.Suppress
+	default:
+		return false, nil
+	}
+	return true, fn.fromConf(conf)
+}
+
+func encodeConf(v interface{}) (map[string]interface{}, error) {
+	conf := map[string]interface{}{}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return conf, json.Unmarshal(data, &conf)
+}
+
+func decodeConf(conf map[string]interface{}, v interface{}) error {
+	data, err := json.Marshal(conf)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func fromStringValues(values []types.String) []string {
+	if values == nil {
+		return nil
+	}
+	return lo.Map(values, func(v types.String, _ int) string {
+		return v.ValueString()
+	})
+}
+
+func toStringValues(values []string) []types.String {
+	if values == nil {
+		return nil
+	}
+	return lo.Map(values, func(v string, _ int) types.String {
+		return types.StringValue(v)
+	})
+}
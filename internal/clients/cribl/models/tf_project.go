@@ -0,0 +1,62 @@
+package models
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// ProjectState resets every attribute of state that is null in prior back to
+// null, the typed counterpart of projectJSON. Cribl returns its defaults for
+// attributes that were never configured, reading those into state would show
+// up as a change to remove them on every plan. prior and state must be
+// pointers to the same model type.
+func ProjectState(prior, state interface{}) {
+	projectValue(reflect.ValueOf(prior).Elem(), reflect.ValueOf(state).Elem())
+}
+
+func projectValue(prior, state reflect.Value) {
+	if value, ok := prior.Interface().(attr.Value); ok {
+		if value.IsNull() {
+			state.Set(prior)
+		}
+		return
+	}
+
+	switch prior.Kind() {
+	case reflect.Ptr:
+		if prior.IsNil() {
+			state.Set(prior)
+		} else if !state.IsNil() {
+			projectValue(prior.Elem(), state.Elem())
+		}
+	case reflect.Slice:
+		if prior.IsNil() {
+			state.Set(prior)
+			return
+		}
+		for i := 0; i < prior.Len() && i < state.Len(); i++ {
+			projectValue(prior.Index(i), state.Index(i))
+		}
+	case reflect.Map:
+		if prior.IsNil() {
+			state.Set(prior)
+			return
+		}
+		for _, key := range state.MapKeys() {
+			priorValue := prior.MapIndex(key)
+			if !priorValue.IsValid() {
+				continue
+			}
+			// map values aren't addressable, project a copy and store it
+			value := reflect.New(state.Type().Elem()).Elem()
+			value.Set(state.MapIndex(key))
+			projectValue(priorValue, value)
+			state.SetMapIndex(key, value)
+		}
+	case reflect.Struct:
+		for i := 0; i < prior.NumField(); i++ {
+			projectValue(prior.Field(i), state.Field(i))
+		}
+	}
+}
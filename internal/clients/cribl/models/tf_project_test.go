@@ -0,0 +1,46 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProjectState(t *testing.T) {
+	prior := InputSyslog{
+		ID:          types.StringValue("in_syslog"),
+		Description: types.StringNull(),
+		UDPPort:     types.Float32Value(9514),
+		StreamTags:  types.ListNull(types.StringType),
+		PQ:          nil,
+		TLS:         &InputTLS{Disabled: types.BoolValue(false)},
+		Metadata:    []MetadataField{{Name: types.StringValue("env"), Value: types.StringNull()}},
+	}
+	// what cribl returns, defaults included
+	var state InputSyslog
+	state.FromCriblInputSyslog(prior.ToCriblInputSyslog())
+	state.Description = types.StringValue("default description")
+	state.MaxBufferSize = types.Float32Value(1000)
+	state.PQ = &InputPQ{Mode: types.StringValue("always")}
+	state.TLS.RequestCert = types.BoolValue(false)
+	state.Metadata[0].Value = types.StringValue("'prod'")
+	state.Metadata = append(state.Metadata, MetadataField{Name: types.StringValue("extra")})
+
+	ProjectState(&prior, &state)
+
+	if !state.ID.Equal(prior.ID) || !state.UDPPort.Equal(prior.UDPPort) {
+		t.Errorf("configured attributes changed: id %v, udp_port %v", state.ID, state.UDPPort)
+	}
+	if !state.Description.IsNull() || !state.MaxBufferSize.IsNull() {
+		t.Errorf("unset attributes read back: description %v, max_buffer_size %v", state.Description, state.MaxBufferSize)
+	}
+	if state.PQ != nil {
+		t.Errorf("unset pq read back: %+v", state.PQ)
+	}
+	if state.TLS == nil || !state.TLS.Disabled.Equal(types.BoolValue(false)) || !state.TLS.RequestCert.IsNull() {
+		t.Errorf("tls not projected: %+v", state.TLS)
+	}
+	if len(state.Metadata) != 2 || !state.Metadata[0].Value.IsNull() {
+		t.Errorf("metadata not projected: %+v", state.Metadata)
+	}
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+type Regex struct {
+	ID          types.String `tfsdk:"id"`
+	Regex       types.String `tfsdk:"regex"`
+	Description types.String `tfsdk:"description"`
+	SampleData  types.String `tfsdk:"sample_data"`
+	Tags        types.List   `tfsdk:"tags"`
+}
+
+func (r *Regex) ToCriblRegexLibEntry() cribl.RegexLibEntry {
+	return cribl.RegexLibEntry{
+		Id:          r.ID.ValueString(),
+		Regex:       r.Regex.ValueString(),
+		Lib:         lo.ToPtr("custom"),
+		Description: r.Description.ValueStringPointer(),
+		SampleData:  r.SampleData.ValueStringPointer(),
+		Tags:        toCriblTags(r.Tags),
+	}
+}
+
+func (r *Regex) FromCriblRegexLibEntry(entry cribl.RegexLibEntry) {
+	r.ID = types.StringValue(entry.Id)
+	r.Regex = types.StringValue(entry.Regex)
+	r.Description = types.StringPointerValue(entry.Description)
+	r.SampleData = types.StringPointerValue(entry.SampleData)
+	r.Tags = fromCriblTags(entry.Tags)
+}
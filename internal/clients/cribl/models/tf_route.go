@@ -1,8 +1,183 @@
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
 
 type Route struct {
-	Name types.String `tfsdk:`
-	
+	ID                     types.String `tfsdk:"id"`
+	RoutesID               types.String `tfsdk:"routes_id"`
+	Name                   types.String `tfsdk:"name"`
+	Filter                 types.String `tfsdk:"filter"`
+	Pipeline               types.String `tfsdk:"pipeline"`
+	Output                 types.String `tfsdk:"output"`
+	Final                  types.Bool   `tfsdk:"final"`
+	EnableOutputExpression types.Bool   `tfsdk:"enable_output_expression"`
+	Description            types.String `tfsdk:"description"`
+	Before                 types.String `tfsdk:"before"`
+	After                  types.String `tfsdk:"after"`
+}
+
+func (r *Route) ToCriblRoutesRoute() cribl.RoutesRoute {
+	out := cribl.RoutesRoute{
+		Id:                     r.ID.ValueStringPointer(),
+		Name:                   r.Name.ValueString(),
+		Filter:                 r.Filter.ValueStringPointer(),
+		Pipeline:               r.Pipeline.ValueString(),
+		Final:                  r.Final.ValueBoolPointer(),
+		EnableOutputExpression: r.EnableOutputExpression.ValueBoolPointer(),
+		Description:            r.Description.ValueStringPointer(),
+	}
+	if !r.Output.IsNull() {
+		var output interface{} = r.Output.ValueString()
+		out.Output = &output
+	}
+	return out
+}
+
+func (r *Route) FromCriblRoutesRoute(model cribl.RoutesRoute) {
+	r.ID = types.StringPointerValue(model.Id)
+	r.Name = types.StringValue(model.Name)
+	r.Filter = types.StringPointerValue(model.Filter)
+	r.Pipeline = types.StringValue(model.Pipeline)
+	// cribl omits final when it is left at its default of true
+	r.Final = types.BoolValue(model.Final == nil || *model.Final)
+	r.EnableOutputExpression = types.BoolPointerValue(model.EnableOutputExpression)
+	r.Description = types.StringPointerValue(model.Description)
+	r.Output = types.StringNull()
+	if model.Output != nil && *model.Output != nil {
+		r.Output = types.StringValue(fmt.Sprintf("%v", *model.Output))
+	}
+}
+
+type RoutesEntry struct {
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Filter                 types.String `tfsdk:"filter"`
+	Pipeline               types.String `tfsdk:"pipeline"`
+	Output                 types.String `tfsdk:"output"`
+	Final                  types.Bool   `tfsdk:"final"`
+	EnableOutputExpression types.Bool   `tfsdk:"enable_output_expression"`
+	Description            types.String `tfsdk:"description"`
+	Disabled               types.Bool   `tfsdk:"disabled"`
+	GroupID                types.String `tfsdk:"group_id"`
+}
+
+type RoutesGroup struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+}
+
+type Routes struct {
+	ID       types.String           `tfsdk:"id"`
+	Routes   []RoutesEntry          `tfsdk:"routes"`
+	Groups   map[string]RoutesGroup `tfsdk:"groups"`
+	Comments types.List             `tfsdk:"comments"`
+}
+
+func (r *Routes) ToCriblRoutes(ctx context.Context) (cribl.Routes, diag.Diagnostics) {
+	out := cribl.Routes{
+		Id:     r.ID.ValueStringPointer(),
+		Routes: []cribl.RoutesRoute{},
+	}
+	for _, route := range r.Routes {
+		entry := cribl.RoutesRoute{
+			Id:                     route.ID.ValueStringPointer(),
+			Name:                   route.Name.ValueString(),
+			Filter:                 route.Filter.ValueStringPointer(),
+			Pipeline:               route.Pipeline.ValueString(),
+			Final:                  route.Final.ValueBoolPointer(),
+			EnableOutputExpression: route.EnableOutputExpression.ValueBoolPointer(),
+			Description:            route.Description.ValueStringPointer(),
+			Disabled:               route.Disabled.ValueBoolPointer(),
+		}
+		if !route.Output.IsNull() {
+			var output interface{} = route.Output.ValueString()
+			entry.Output = &output
+		}
+		if !route.GroupID.IsNull() {
+			entry.Set("groupId", route.GroupID.ValueString())
+		}
+		out.Routes = append(out.Routes, entry)
+	}
+	if r.Groups != nil {
+		groups := map[string]cribl.RoutesGroup{}
+		for id, group := range r.Groups {
+			groups[id] = cribl.RoutesGroup{
+				Name:        group.Name.ValueString(),
+				Description: group.Description.ValueStringPointer(),
+				Disabled:    group.Disabled.ValueBoolPointer(),
+			}
+		}
+		out.Groups = &groups
+	}
+	if !r.Comments.IsNull() {
+		var comments []string
+		if diags := r.Comments.ElementsAs(ctx, &comments, false); diags.HasError() {
+			return out, diags
+		}
+		items := lo.Map(comments, func(comment string, _ int) cribl.Routes_Comments_Item {
+			return cribl.Routes_Comments_Item{Comment: lo.ToPtr(comment)}
+		})
+		out.Comments = &items
+	}
+	return out, nil
+}
+
+func (r *Routes) FromCriblRoutes(ctx context.Context, model cribl.Routes) diag.Diagnostics {
+	r.ID = types.StringPointerValue(model.Id)
+	r.Routes = nil
+	for _, route := range model.Routes {
+		entry := RoutesEntry{
+			ID:                     types.StringPointerValue(route.Id),
+			Name:                   types.StringValue(route.Name),
+			Filter:                 types.StringPointerValue(route.Filter),
+			Pipeline:               types.StringValue(route.Pipeline),
+			Final:                  types.BoolValue(route.Final == nil || *route.Final),
+			EnableOutputExpression: types.BoolPointerValue(route.EnableOutputExpression),
+			Description:            types.StringPointerValue(route.Description),
+			Disabled:               types.BoolPointerValue(route.Disabled),
+			Output:                 types.StringNull(),
+			GroupID:                types.StringNull(),
+		}
+		if route.Output != nil && *route.Output != nil {
+			entry.Output = types.StringValue(fmt.Sprintf("%v", *route.Output))
+		}
+		if groupID, ok := route.Get("groupId"); ok && groupID != nil {
+			entry.GroupID = types.StringValue(fmt.Sprintf("%v", groupID))
+		}
+		r.Routes = append(r.Routes, entry)
+	}
+	// empty groups and comments only replace null ones when cribl omits
+	// them, tables that never had any may come back with empty ones
+	if model.Groups == nil || (len(*model.Groups) == 0 && r.Groups == nil) {
+		r.Groups = nil
+	} else {
+		r.Groups = map[string]RoutesGroup{}
+		for id, group := range *model.Groups {
+			r.Groups[id] = RoutesGroup{
+				Name:        types.StringValue(group.Name),
+				Description: types.StringPointerValue(group.Description),
+				Disabled:    types.BoolPointerValue(group.Disabled),
+			}
+		}
+	}
+	if model.Comments == nil || (len(*model.Comments) == 0 && r.Comments.IsNull()) {
+		r.Comments = types.ListNull(types.StringType)
+	} else {
+		comments := lo.Map(*model.Comments, func(item cribl.Routes_Comments_Item, _ int) string {
+			return lo.FromPtr(item.Comment)
+		})
+		var diags diag.Diagnostics
+		r.Comments, diags = types.ListValueFrom(ctx, types.StringType, comments)
+		return diags
+	}
+	return nil
 }
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/samber/lo"
+)
+
+// Secret is a system secret. The *_wo attributes are write-only, they are
+// only ever set in the configuration, never in plan or state.
+type Secret struct {
+	ID              types.String `tfsdk:"id"`
+	SecretType      types.String `tfsdk:"secret_type"`
+	Description     types.String `tfsdk:"description"`
+	Tags            types.List   `tfsdk:"tags"`
+	ValueWO         types.String `tfsdk:"value_wo"`
+	ApiKey          types.String `tfsdk:"api_key"`
+	SecretKeyWO     types.String `tfsdk:"secret_key_wo"`
+	Username        types.String `tfsdk:"username"`
+	PasswordWO      types.String `tfsdk:"password_wo"`
+	SecretWOVersion types.Int64  `tfsdk:"secret_wo_version"`
+}
+
+func (s *Secret) ToCriblSecret() cribl.RestSecret {
+	return cribl.RestSecret{
+		Id:          s.ID.ValueString(),
+		SecretType:  cribl.SecretType(s.SecretType.ValueString()),
+		Description: s.Description.ValueStringPointer(),
+		Tags:        toCriblTags(s.Tags),
+		Value:       s.ValueWO.ValueStringPointer(),
+		ApiKey:      s.ApiKey.ValueStringPointer(),
+		SecretKey:   s.SecretKeyWO.ValueStringPointer(),
+		Username:    s.Username.ValueStringPointer(),
+		Password:    s.PasswordWO.ValueStringPointer(),
+	}
+}
+
+// FromCriblSecret reads back everything but the secret values, which Cribl
+// returns redacted.
+func (s *Secret) FromCriblSecret(secret cribl.RestSecret) {
+	s.ID = types.StringValue(secret.Id)
+	s.SecretType = types.StringValue(string(secret.SecretType))
+	s.Description = types.StringPointerValue(secret.Description)
+	s.Tags = fromCriblTags(secret.Tags)
+	s.ApiKey = types.StringPointerValue(secret.ApiKey)
+	s.Username = types.StringPointerValue(secret.Username)
+}
+
+// Validate checks that the attributes needed by secret_type are set, and
+// only those.
+func (s *Secret) Validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if s.SecretType.IsNull() || s.SecretType.IsUnknown() {
+		return diags
+	}
+	fields := map[string]types.String{
+		"value_wo":      s.ValueWO,
+		"api_key":       s.ApiKey,
+		"secret_key_wo": s.SecretKeyWO,
+		"username":      s.Username,
+		"password_wo":   s.PasswordWO,
+	}
+	var required []string
+	switch secretType := cribl.SecretType(s.SecretType.ValueString()); secretType {
+	case cribl.Text:
+		required = []string{"value_wo"}
+	case cribl.Keypair:
+		required = []string{"api_key", "secret_key_wo"}
+	case cribl.Credentials:
+		required = []string{"username", "password_wo"}
+	default:
+		diags.AddAttributeError(
+			path.Root("secret_type"),
+			"Invalid secret type",
+			fmt.Sprintf("secret_type must be one of text, keypair or credentials, got %q.", secretType),
+		)
+		return diags
+	}
+
+	for _, field := range []string{"value_wo", "api_key", "secret_key_wo", "username", "password_wo"} {
+		isRequired := lo.Contains(required, field)
+		switch value := fields[field]; {
+		case isRequired && value.IsNull():
+			diags.AddAttributeError(
+				path.Root(field),
+				"Missing secret attribute",
+				fmt.Sprintf("%s must be set when secret_type is %s.", field, s.SecretType.ValueString()),
+			)
+		case !isRequired && !value.IsNull():
+			diags.AddAttributeError(
+				path.Root(field),
+				"Unexpected secret attribute",
+				fmt.Sprintf("%s can't be set when secret_type is %s.", field, s.SecretType.ValueString()),
+			)
+		}
+	}
+	return diags
+}
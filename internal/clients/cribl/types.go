@@ -0,0 +1,555 @@
+package cribl
+
+// The types below are referenced from the spec with x-go-type in place of
+// inline schemas, either because several schemas share them or because the
+// spec leaves them too loose to use. Keep them in sync with the spec when it
+// is updated, wrapper.go is regenerated with go generate.
+
+// Defines values for EventBreakerRulesetRulesTimestampType.
+const (
+	EventBreakerRulesetRulesTimestampTypeAuto    EventBreakerRulesetRulesTimestampType = "auto"
+	EventBreakerRulesetRulesTimestampTypeCurrent EventBreakerRulesetRulesTimestampType = "current"
+	EventBreakerRulesetRulesTimestampTypeFormat  EventBreakerRulesetRulesTimestampType = "format"
+)
+
+// Defines values for EventBreakerRulesetRulesType.
+const (
+	EventBreakerRulesetRulesTypeAwsCloudtrail EventBreakerRulesetRulesType = "aws_cloudtrail"
+	EventBreakerRulesetRulesTypeAwsVpcflow    EventBreakerRulesetRulesType = "aws_vpcflow"
+	EventBreakerRulesetRulesTypeCsv           EventBreakerRulesetRulesType = "csv"
+	EventBreakerRulesetRulesTypeHeader        EventBreakerRulesetRulesType = "header"
+	EventBreakerRulesetRulesTypeJson          EventBreakerRulesetRulesType = "json"
+	EventBreakerRulesetRulesTypeJsonArray     EventBreakerRulesetRulesType = "json_array"
+	EventBreakerRulesetRulesTypeRegex         EventBreakerRulesetRulesType = "regex"
+	EventBreakerRulesetRulesTypeTimestamp     EventBreakerRulesetRulesType = "timestamp"
+)
+
+// Defines values for InputSplunkHecAuthTokensAuthType.
+const (
+	InputSplunkHecAuthTokensAuthTypeManual InputSplunkHecAuthTokensAuthType = "manual"
+	InputSplunkHecAuthTokensAuthTypeSecret InputSplunkHecAuthTokensAuthType = "secret"
+)
+
+// Defines values for OutputElasticAuthAuthType.
+const (
+	OutputElasticAuthAuthTypeManual       OutputElasticAuthAuthType = "manual"
+	OutputElasticAuthAuthTypeManualAPIKey OutputElasticAuthAuthType = "manualAPIKey"
+	OutputElasticAuthAuthTypeSecret       OutputElasticAuthAuthType = "secret"
+	OutputElasticAuthAuthTypeTextSecret   OutputElasticAuthAuthType = "textSecret"
+)
+
+// Defines values for OutputSplunkLbHostsTls.
+const (
+	OutputSplunkLbHostsTlsInherit OutputSplunkLbHostsTls = "inherit"
+	OutputSplunkLbHostsTlsOff     OutputSplunkLbHostsTls = "off"
+)
+
+// Defines values for OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthType.
+const (
+	OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthTypeManual OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthType = "manual"
+	OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthTypeSecret OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthType = "secret"
+)
+
+// Defines values for OutputSplunkLbIndexerDiscoveryConfigsAuthType.
+const (
+	OutputSplunkLbIndexerDiscoveryConfigsAuthTypeManual OutputSplunkLbIndexerDiscoveryConfigsAuthType = "manual"
+	OutputSplunkLbIndexerDiscoveryConfigsAuthTypeSecret OutputSplunkLbIndexerDiscoveryConfigsAuthType = "secret"
+)
+
+// EventBreakerRulesetRule Rule of an event breaker ruleset
+type EventBreakerRulesetRule struct {
+	// Condition The JavaScript filter expression used to match the data to apply the rule to
+	Condition string `json:"condition"`
+
+	// Disabled Disable this breaker rule (enabled by default)
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// EventBreakerRegex The regex to break the stream into events on, used by the regex type
+	EventBreakerRegex *string `json:"eventBreakerRegex,omitempty"`
+
+	// Fields Key-value pairs to be added to each event
+	Fields *[]EventBreakerRulesetRuleField `json:"fields,omitempty"`
+
+	// MaxEventBytes The maximum number of bytes in an event before it is flushed to the pipelines
+	MaxEventBytes *float32 `json:"maxEventBytes,omitempty"`
+	Name          string   `json:"name"`
+	ParserEnabled *bool    `json:"parserEnabled,omitempty"`
+
+	// ShouldUseDataRaw Enable to set an internal field on events indicating that the field in the data called _raw should be used. This can be useful for post processors that want to use that field for event._raw, instead of replacing it with the actual raw event.
+	ShouldUseDataRaw *bool `json:"shouldUseDataRaw,omitempty"`
+
+	// Timestamp Auto, manual format (strptime), or current time
+	Timestamp EventBreakerRulesetRuleTimestamp `json:"timestamp"`
+
+	// TimestampAnchorRegex The regex to match before attempting timestamp extraction. Use $ (end-of-string anchor) to prevent extraction.
+	TimestampAnchorRegex string `json:"timestampAnchorRegex"`
+
+	// TimestampEarliest The earliest timestamp value allowed relative to now. Example: -42years. Parsed values prior to this date will be set to current time.
+	TimestampEarliest *string `json:"timestampEarliest,omitempty"`
+
+	// TimestampLatest The latest timestamp value allowed relative to now. Example: +42days. Parsed values after this date will be set to current time.
+	TimestampLatest *string `json:"timestampLatest,omitempty"`
+
+	// TimestampTimezone Timezone to assign to timestamps without timezone info
+	TimestampTimezone *string                      `json:"timestampTimezone,omitempty"`
+	Type              EventBreakerRulesetRulesType `json:"type"`
+}
+
+// EventBreakerRulesetRuleField Field to add to each event broken by a rule
+type EventBreakerRulesetRuleField struct {
+	Name *string `json:"name,omitempty"`
+
+	// Value The JavaScript expression used to compute the field's value (can be constant)
+	Value string `json:"value"`
+}
+
+// EventBreakerRulesetRuleTimestamp How a rule extracts the event timestamp
+type EventBreakerRulesetRuleTimestamp struct {
+	Format *string                               `json:"format,omitempty"`
+	Length *float32                              `json:"length,omitempty"`
+	Type   EventBreakerRulesetRulesTimestampType `json:"type"`
+}
+
+// EventBreakerRulesetRulesTimestampType Auto, manual format (strptime), or current time
+type EventBreakerRulesetRulesTimestampType string
+
+// EventBreakerRulesetRulesType Event breaker type of a rule
+type EventBreakerRulesetRulesType string
+
+// GlobalVarArg Argument of an expression global variable
+type GlobalVarArg struct {
+	Name string  `json:"name"`
+	Type *string `json:"type,omitempty"`
+}
+
+// InputConnection Direct connection to a Destination, optionally via a Pipeline or a Pack
+type InputConnection struct {
+	// Output Select a Destination.
+	Output string `json:"output"`
+	// Pipeline Select Pipeline or Pack. Optional.
+	Pipeline *string `json:"pipeline,omitempty"`
+}
+
+// InputMetadata Field to add to events from an input
+type InputMetadata struct {
+	// Name Field name
+	Name string `json:"name"`
+
+	// Value JavaScript expression to compute field's value, enclosed in quotes or backticks. (Can evaluate to a constant.)
+	Value string `json:"value"`
+}
+
+// InputPq Persistent queue settings shared by inputs
+type InputPq struct {
+	// CommitFrequency The number of events to send downstream before committing that Stream has read them.
+	CommitFrequency *float32 `json:"commitFrequency,omitempty"`
+
+	// Compress Codec to use to compress the persisted data.
+	Compress *string `json:"compress,omitempty"`
+
+	// MaxBufferSize The maximum number of events to hold in memory before writing the events to disk.
+	MaxBufferSize *float32 `json:"maxBufferSize,omitempty"`
+
+	// MaxFileSize The maximum size to store in each queue file before closing and optionally compressing. Enter a numeral with units of KB, MB, etc.
+	MaxFileSize *string `json:"maxFileSize,omitempty"`
+
+	// MaxSize The maximum disk space that the queue can consume (as an average per Worker Process) before queueing stops. Enter a numeral with units of KB, MB, etc.
+	MaxSize *string `json:"maxSize,omitempty"`
+
+	// Mode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
+	Mode *string `json:"mode,omitempty"`
+
+	// Path The location for the persistent queue files. To this field's value, the system will append: /<worker-id>/inputs/<input-id>.
+	Path *string `json:"path,omitempty"`
+}
+
+// InputPreprocess Custom command to feed the data through before it's processed
+type InputPreprocess struct {
+	// Args Arguments to be added to the custom command
+	Args *[]string `json:"args,omitempty"`
+
+	// Command Command to feed the data through (via stdin) and process its output (stdout)
+	Command *string `json:"command,omitempty"`
+
+	// Disabled Enable Custom Command
+	Disabled bool `json:"disabled"`
+}
+
+// InputAuthTokenExt Shared secret to be provided by any client, with fields to add to events referencing it
+type InputAuthTokenExt struct {
+	// Description Optional token description
+	Description *string `json:"description,omitempty"`
+
+	// Metadata Fields to add to events referencing this token
+	Metadata *[]InputMetadata `json:"metadata,omitempty"`
+
+	// Token Shared secret to be provided by any client (Authorization: <token>)
+	Token string `json:"token"`
+}
+
+// InputTlsServerSide TLS settings shared by inputs that listen for connections
+type InputTlsServerSide struct {
+	// CaPath Path on server containing CA certificates to use. PEM format. Can reference $ENV_VARS.
+	CaPath *string `json:"caPath,omitempty"`
+
+	// CertPath Path on server containing certificates to use. PEM format. Can reference $ENV_VARS.
+	CertPath *string `json:"certPath,omitempty"`
+
+	// CertificateName The name of the predefined certificate.
+	CertificateName *string      `json:"certificateName,omitempty"`
+	CommonNameRegex *interface{} `json:"commonNameRegex,omitempty"`
+	Disabled        *bool        `json:"disabled,omitempty"`
+
+	// MaxVersion Maximum TLS version to accept from connections
+	MaxVersion *string `json:"maxVersion,omitempty"`
+
+	// MinVersion Minimum TLS version to accept from connections
+	MinVersion *string `json:"minVersion,omitempty"`
+
+	// Passphrase Passphrase to use to decrypt private key.
+	Passphrase *string `json:"passphrase,omitempty"`
+
+	// PrivKeyPath Path on server containing the private key to use. PEM format. Can reference $ENV_VARS.
+	PrivKeyPath        *string      `json:"privKeyPath,omitempty"`
+	RejectUnauthorized *interface{} `json:"rejectUnauthorized,omitempty"`
+
+	// RequestCert Whether to require clients to present their certificates. Used to perform client authentication using SSL certs.
+	RequestCert *bool `json:"requestCert,omitempty"`
+}
+
+// OutputTlsClientSide TLS settings shared by outputs that open connections
+type OutputTlsClientSide struct {
+	// CaPath Path on client in which to find CA certificates to verify the server's cert. PEM format. Can reference $ENV_VARS.
+	CaPath *string `json:"caPath,omitempty"`
+
+	// CertPath Path on client in which to find certificates to use. PEM format. Can reference $ENV_VARS.
+	CertPath *string `json:"certPath,omitempty"`
+
+	// CertificateName The name of the predefined certificate.
+	CertificateName *string `json:"certificateName,omitempty"`
+	Disabled        *bool   `json:"disabled,omitempty"`
+
+	// MaxVersion Maximum TLS version to use when connecting
+	MaxVersion *string `json:"maxVersion,omitempty"`
+
+	// MinVersion Minimum TLS version to use when connecting
+	MinVersion *string `json:"minVersion,omitempty"`
+
+	// Passphrase Passphrase to use to decrypt private key.
+	Passphrase *string `json:"passphrase,omitempty"`
+
+	// PrivKeyPath Path on client in which to find the private key to use. PEM format. Can reference $ENV_VARS.
+	PrivKeyPath *string `json:"privKeyPath,omitempty"`
+
+	// RejectUnauthorized Reject certs that are not authorized by a CA in the CA certificate path, or by another
+	//                     trusted CA (e.g., the system's CA). Defaults to Yes. Overrides the toggle from Advanced Settings, when also present.
+	RejectUnauthorized *bool `json:"rejectUnauthorized,omitempty"`
+
+	// Servername Server name for the SNI (Server Name Indication) TLS extension. It must be a host name, and not an IP address.
+	Servername *string `json:"servername,omitempty"`
+}
+
+// OutputExtraHttpHeader Header to add to every request sent by an HTTP based output
+type OutputExtraHttpHeader struct {
+	// Name Field name
+	Name *string `json:"name,omitempty"`
+
+	// Value Field value
+	Value string `json:"value"`
+}
+
+// OutputExtraParam Extra parameter to send with requests
+type OutputExtraParam struct {
+	// Name Field name
+	Name string `json:"name"`
+
+	// Value Field value
+	Value string `json:"value"`
+}
+
+// OauthHeader Header to send in the OAuth login request
+type OauthHeader struct {
+	// Name OAuth header name
+	Name string `json:"name"`
+
+	// Value OAuth header value
+	Value string `json:"value"`
+}
+
+// OauthParam Parameter to send in the OAuth login request
+type OauthParam struct {
+	// Name OAuth parameter name
+	Name string `json:"name"`
+
+	// Value OAuth parameter value
+	Value string `json:"value"`
+}
+
+// OutputLoadBalancedUrl Endpoint of a load-balanced HTTP based output
+type OutputLoadBalancedUrl struct {
+	// Url The URL to send events to.
+	Url string `json:"url"`
+
+	// Weight Assign a weight (>0) to each endpoint to indicate its traffic-handling capability
+	Weight *float32 `json:"weight,omitempty"`
+}
+
+// OutputResponseRetrySetting Retry behavior for an unsuccessful response status code
+type OutputResponseRetrySetting struct {
+	// BackoffRate Base for exponential backoff. A value of 2 (default) means Cribl Stream will retry after 2 seconds, then 4 seconds, then 8 seconds, etc.
+	BackoffRate *float32 `json:"backoffRate,omitempty"`
+
+	// HttpStatus The HTTP response status code that will trigger retries
+	HttpStatus float32 `json:"httpStatus"`
+
+	// InitialBackoff How long, in milliseconds, Cribl Stream should wait before initiating backoff. Maximum interval is 600,000 ms (10 minutes).
+	InitialBackoff *float32 `json:"initialBackoff,omitempty"`
+
+	// MaxBackoff The maximum backoff interval, in milliseconds, Cribl Stream should apply. Default (and minimum) is 10,000 ms (10 seconds); maximum is 180,000 ms (180 seconds).
+	MaxBackoff *float32 `json:"maxBackoff,omitempty"`
+}
+
+// OutputTimeoutRetrySettings Retry behavior for requests that time out
+type OutputTimeoutRetrySettings struct {
+	// BackoffRate Base for exponential backoff. A value of 2 (default) means Cribl Stream will retry after 2 seconds, then 4 seconds, then 8 seconds, etc.
+	BackoffRate *float32 `json:"backoffRate,omitempty"`
+
+	// InitialBackoff How long, in milliseconds, Cribl Stream should wait before initiating backoff. Maximum interval is 600,000 ms (10 minutes).
+	InitialBackoff *float32 `json:"initialBackoff,omitempty"`
+
+	// MaxBackoff The maximum backoff interval, in milliseconds, Cribl Stream should apply. Default (and minimum) is 10,000 ms (10 seconds); maximum is 180,000 ms (180 seconds).
+	MaxBackoff *float32 `json:"maxBackoff,omitempty"`
+
+	// TimeoutRetry Enable to retry on request timeout
+	TimeoutRetry bool `json:"timeoutRetry"`
+}
+
+// OutputKeyValueMetadata Key-value pair to include in the metadata of files the Destination writes
+type OutputKeyValueMetadata struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// KafkaSasl Authentication parameters to use when connecting to Kafka brokers
+type KafkaSasl struct {
+	// AuthType Enter credentials directly, or select a stored secret
+	AuthType *string `json:"authType,omitempty"`
+
+	// CredentialsSecret Select or create a secret that references your credentials
+	CredentialsSecret *string `json:"credentialsSecret,omitempty"`
+
+	// Disabled Enable Authentication
+	Disabled bool `json:"disabled"`
+
+	// Mechanism SASL authentication mechanism to use.
+	Mechanism *string `json:"mechanism,omitempty"`
+
+	// Password Password to authenticate with
+	Password *string `json:"password,omitempty"`
+
+	// Username Username to authenticate with
+	Username *string `json:"username,omitempty"`
+}
+
+// KafkaSchemaRegistry Confluent Schema Registry settings shared by the Kafka input and output
+type KafkaSchemaRegistry struct {
+	// Auth Credentials to use when authenticating with the schema registry using basic HTTP authentication
+	Auth *KafkaSchemaRegistryAuth `json:"auth,omitempty"`
+
+	// ConnectionTimeout Maximum time to wait for a Schema Registry connection to complete successfully
+	ConnectionTimeout *float32 `json:"connectionTimeout,omitempty"`
+
+	// DefaultKeySchemaId Used when __keySchemaIdOut is not present, to transform key values, leave blank if key transformation is not required by default. Output only.
+	DefaultKeySchemaId *float32 `json:"defaultKeySchemaId,omitempty"`
+
+	// DefaultValueSchemaId Used when __valueSchemaIdOut is not present, to transform _raw, leave blank if value transformation is not required by default. Output only.
+	DefaultValueSchemaId *float32 `json:"defaultValueSchemaId,omitempty"`
+
+	// Disabled Enable Schema Registry
+	Disabled bool `json:"disabled"`
+
+	// MaxRetries Maximum number of times to try fetching schemas from the Schema Registry
+	MaxRetries *float32 `json:"maxRetries,omitempty"`
+
+	// RequestTimeout Maximum time to wait for the Schema Registry to respond to a request
+	RequestTimeout *float32 `json:"requestTimeout,omitempty"`
+
+	// SchemaRegistryURL URL for accessing the Confluent Schema Registry. Example: http://localhost:8081. To connect over TLS, use https instead of http.
+	SchemaRegistryURL *string              `json:"schemaRegistryURL,omitempty"`
+	Tls               *OutputTlsClientSide `json:"tls,omitempty"`
+}
+
+// KafkaSchemaRegistryAuth Basic HTTP authentication for the schema registry
+type KafkaSchemaRegistryAuth struct {
+	// CredentialsSecret Select or create a secret that references your credentials
+	CredentialsSecret *string `json:"credentialsSecret,omitempty"`
+
+	// Disabled Enable authentication
+	Disabled bool `json:"disabled"`
+}
+
+// InputDatagenSample Sample file to generate events from, and the rate to generate them at
+type InputDatagenSample struct {
+	// EventsPerSec Maximum no. of events to generate per second per worker node. Defaults to 10.
+	EventsPerSec float32 `json:"eventsPerSec"`
+
+	// Sample Name of the datagen file
+	Sample string `json:"sample"`
+}
+
+// InputS3Checkpointing Checkpointing settings to resume processing files after an interruption
+type InputS3Checkpointing struct {
+	// Enabled Enable checkpointing to resume processing files after an interruption.
+	Enabled bool `json:"enabled"`
+
+	// Retries If checkpointing is enabled, the number of times to retry processing when a processing error occurs. If skip file on error is enabled, this setting is ignored.
+	Retries *float32 `json:"retries,omitempty"`
+}
+
+// InputSplunkHecAuthToken Shared secret to be provided by any client (Authorization: <token>).
+type InputSplunkHecAuthToken struct {
+	// AllowedIndexesAtToken Enter the values you want to allow in the HEC event index field at the token level. Supports wildcards. To skip validation, leave blank.
+	AllowedIndexesAtToken *[]string `json:"allowedIndexesAtToken,omitempty"`
+
+	// AuthType Enter a token directly, or provide a secret referencing a token
+	AuthType *InputSplunkHecAuthTokensAuthType `json:"authType,omitempty"`
+
+	// Description Optional token description
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+
+	// Metadata Fields to add to events referencing this token
+	Metadata *[]InputMetadata `json:"metadata,omitempty"`
+
+	// Token Shared secret, left out when the token is read from TokenSecret
+	Token       interface{}  `json:"token,omitempty"`
+	TokenSecret *interface{} `json:"tokenSecret,omitempty"`
+}
+
+// InputSplunkHecAuthTokensAuthType Enter a token directly, or provide a secret referencing a token
+type InputSplunkHecAuthTokensAuthType string
+
+// OutputElasticAuth Credentials to use when sending events to Elasticsearch
+type OutputElasticAuth struct {
+	// AuthType Enter credentials directly, or select a stored secret
+	AuthType *OutputElasticAuthAuthType `json:"authType,omitempty"`
+
+	// CredentialsSecret Select or create a secret that references your credentials
+	CredentialsSecret *string `json:"credentialsSecret,omitempty"`
+	Disabled          bool    `json:"disabled"`
+
+	// ManualAPIKey Enter API key directly
+	ManualAPIKey *string `json:"manualAPIKey,omitempty"`
+
+	// Password Password for basic authentication
+	Password *string `json:"password,omitempty"`
+
+	// TextSecret Select or create a stored text secret
+	TextSecret *string `json:"textSecret,omitempty"`
+
+	// Username Username for basic authentication
+	Username *string `json:"username,omitempty"`
+}
+
+// OutputElasticAuthAuthType Enter credentials directly, or select a stored secret
+type OutputElasticAuthAuthType string
+
+// OutputOpenTelemetryMetadata Key-value pair to send with each gRPC request
+type OutputOpenTelemetryMetadata struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// OutputSplunkLbHost Splunk indexer to load-balance data to
+type OutputSplunkLbHost struct {
+	// Host The hostname of the receiver.
+	Host string `json:"host"`
+
+	// Port The port to connect to on the provided host.
+	Port float32 `json:"port"`
+
+	// Servername Servername to use if establishing a TLS connection. If not specified, defaults to connection host (iff not an IP); otherwise, to the global TLS settings.
+	Servername *string `json:"servername,omitempty"`
+
+	// Tls Whether to inherit TLS configs from group setting or disable TLS.
+	Tls *OutputSplunkLbHostsTls `json:"tls,omitempty"`
+
+	// Weight Assign a weight (>0) to each endpoint to indicate its traffic-handling capability
+	Weight *float32 `json:"weight,omitempty"`
+}
+
+// OutputSplunkLbIndexerDiscoveryConfigs Configuration to set up indexer discovery in Splunk Indexer clustering environment.
+type OutputSplunkLbIndexerDiscoveryConfigs struct {
+	// AuthToken Shared secret to be provided by any client (in authToken header field). If empty, unauthed access is permitted.
+	AuthToken *string `json:"authToken,omitempty"`
+
+	// AuthTokens Tokens required to authenticate to cluster manager for indexer discovery
+	AuthTokens *[]struct {
+		// AuthType Enter a token directly, or provide a secret referencing a token
+		AuthType *OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthType `json:"authType,omitempty"`
+	} `json:"authTokens,omitempty"`
+
+	// AuthType Enter a token directly, or provide a secret referencing a token
+	AuthType *OutputSplunkLbIndexerDiscoveryConfigsAuthType `json:"authType,omitempty"`
+
+	// MasterUri Full URI of Splunk cluster manager (scheme://host:port). E.g.: https://managerAddress:8089
+	MasterUri string `json:"masterUri"`
+
+	// RefreshIntervalSec Time interval, in seconds, between two consecutive indexer list fetches from cluster manager
+	RefreshIntervalSec float32 `json:"refreshIntervalSec"`
+
+	// RejectUnauthorized During indexer discovery, reject cluster manager certificates that are not authorized by the system's CA. Disable to allow untrusted (for example, self-signed) certificates.
+	RejectUnauthorized *bool `json:"rejectUnauthorized,omitempty"`
+
+	// Site Clustering site of the indexers from where indexers need to be discovered. In case of single site cluster, it defaults to 'default' site.
+	Site string `json:"site"`
+
+	// TextSecret Select or create a stored text secret
+	TextSecret *string `json:"textSecret,omitempty"`
+}
+
+// OutputSplunkLbHostsTls Whether to inherit TLS configs from group setting or disable TLS.
+type OutputSplunkLbHostsTls string
+
+// OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthType Enter a token directly, or provide a secret referencing a token
+type OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthType string
+
+// OutputSplunkLbIndexerDiscoveryConfigsAuthType Enter a token directly, or provide a secret referencing a token
+type OutputSplunkLbIndexerDiscoveryConfigsAuthType string
+
+// PipelineGroup Group of functions in a pipeline
+type PipelineGroup struct {
+	// Description Short description of this group
+	Description *string `json:"description,omitempty"`
+
+	// Disabled Whether this group is disabled
+	Disabled *bool  `json:"disabled,omitempty"`
+	Name     string `json:"name"`
+}
+
+// PipelineConf Pipeline settings and functions
+type PipelineConf struct {
+	// AsyncFuncTimeout Time (in ms) to wait for an async function to complete processing of a data item
+	AsyncFuncTimeout *int    `json:"asyncFuncTimeout,omitempty"`
+	Description      *string `json:"description,omitempty"`
+
+	// Functions List of Functions to pass data through
+	Functions *[]PipelineFunctionConf   `json:"functions,omitempty"`
+	Groups    *map[string]PipelineGroup `json:"groups,omitempty"`
+
+	// Output The output destination for events processed by this Pipeline
+	Output *string `json:"output,omitempty"`
+
+	// Streamtags Tags for filtering and grouping in @{product}
+	Streamtags *[]string `json:"streamtags,omitempty"`
+}
+
+// RoutesGroup Group of routes in a routing table
+type RoutesGroup struct {
+	// Description Short description of this group
+	Description *string `json:"description,omitempty"`
+
+	// Disabled Whether this group is disabled
+	Disabled *bool  `json:"disabled,omitempty"`
+	Name     string `json:"name"`
+}
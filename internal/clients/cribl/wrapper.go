@@ -1,4 +1,4 @@
-// Package criblApidocs4 provides primitives to interact with the openapi HTTP API.
+// Package cribl provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package cribl
@@ -331,25 +331,6 @@ const (
 	EventBreakerRulesetLibCustom      EventBreakerRulesetLib = "custom"
 )
 
-// Defines values for EventBreakerRulesetRulesTimestampType.
-const (
-	EventBreakerRulesetRulesTimestampTypeAuto    EventBreakerRulesetRulesTimestampType = "auto"
-	EventBreakerRulesetRulesTimestampTypeCurrent EventBreakerRulesetRulesTimestampType = "current"
-	EventBreakerRulesetRulesTimestampTypeFormat  EventBreakerRulesetRulesTimestampType = "format"
-)
-
-// Defines values for EventBreakerRulesetRulesType.
-const (
-	EventBreakerRulesetRulesTypeAwsCloudtrail EventBreakerRulesetRulesType = "aws_cloudtrail"
-	EventBreakerRulesetRulesTypeAwsVpcflow    EventBreakerRulesetRulesType = "aws_vpcflow"
-	EventBreakerRulesetRulesTypeCsv           EventBreakerRulesetRulesType = "csv"
-	EventBreakerRulesetRulesTypeHeader        EventBreakerRulesetRulesType = "header"
-	EventBreakerRulesetRulesTypeJson          EventBreakerRulesetRulesType = "json"
-	EventBreakerRulesetRulesTypeJsonArray     EventBreakerRulesetRulesType = "json_array"
-	EventBreakerRulesetRulesTypeRegex         EventBreakerRulesetRulesType = "regex"
-	EventBreakerRulesetRulesTypeTimestamp     EventBreakerRulesetRulesType = "timestamp"
-)
-
 // Defines values for Filter.
 const (
 	FilterN2 Filter = 2
@@ -370,13 +351,14 @@ const (
 
 // Defines values for GlobalVarType.
 const (
-	GlobalVarTypeAny        GlobalVarType = "any"
-	GlobalVarTypeArray      GlobalVarType = "array"
-	GlobalVarTypeBoolean    GlobalVarType = "boolean"
-	GlobalVarTypeExpression GlobalVarType = "expression"
-	GlobalVarTypeNumber     GlobalVarType = "number"
-	GlobalVarTypeObject     GlobalVarType = "object"
-	GlobalVarTypeString     GlobalVarType = "string"
+	GlobalVarTypeAny             GlobalVarType = "any"
+	GlobalVarTypeArray           GlobalVarType = "array"
+	GlobalVarTypeBoolean         GlobalVarType = "boolean"
+	GlobalVarTypeEncryptedString GlobalVarType = "encrypted_string"
+	GlobalVarTypeExpression      GlobalVarType = "expression"
+	GlobalVarTypeNumber          GlobalVarType = "number"
+	GlobalVarTypeObject          GlobalVarType = "object"
+	GlobalVarTypeString          GlobalVarType = "string"
 )
 
 // Defines values for InputAppscopeAuthType.
@@ -896,18 +878,6 @@ const (
 	DatadogAgent InputDatadogAgentType = "datadog_agent"
 )
 
-// Defines values for InputDatagenPqCompress.
-const (
-	InputDatagenPqCompressGzip InputDatagenPqCompress = "gzip"
-	InputDatagenPqCompressNone InputDatagenPqCompress = "none"
-)
-
-// Defines values for InputDatagenPqMode.
-const (
-	InputDatagenPqModeAlways InputDatagenPqMode = "always"
-	InputDatagenPqModeSmart  InputDatagenPqMode = "smart"
-)
-
 // Defines values for InputDatagenPqEnabled.
 const (
 	InputDatagenPqEnabledFalse InputDatagenPqEnabled = false
@@ -1425,18 +1395,6 @@ const (
 	Grafana InputGrafanaType = "grafana"
 )
 
-// Defines values for InputHttpPqCompress.
-const (
-	InputHttpPqCompressGzip InputHttpPqCompress = "gzip"
-	InputHttpPqCompressNone InputHttpPqCompress = "none"
-)
-
-// Defines values for InputHttpPqMode.
-const (
-	InputHttpPqModeAlways InputHttpPqMode = "always"
-	InputHttpPqModeSmart  InputHttpPqMode = "smart"
-)
-
 // Defines values for InputHttpPqEnabled.
 const (
 	InputHttpPqEnabledFalse InputHttpPqEnabled = false
@@ -1449,45 +1407,11 @@ const (
 	InputHttpSendToRoutesTrue  InputHttpSendToRoutes = true
 )
 
-// Defines values for InputHttpTlsDisabled.
-const (
-	InputHttpTlsDisabledFalse InputHttpTlsDisabled = false
-	InputHttpTlsDisabledTrue  InputHttpTlsDisabled = true
-)
-
-// Defines values for InputHttpTlsMaxVersion.
-const (
-	InputHttpTlsMaxVersionTLSv1  InputHttpTlsMaxVersion = "TLSv1"
-	InputHttpTlsMaxVersionTLSv11 InputHttpTlsMaxVersion = "TLSv1.1"
-	InputHttpTlsMaxVersionTLSv12 InputHttpTlsMaxVersion = "TLSv1.2"
-	InputHttpTlsMaxVersionTLSv13 InputHttpTlsMaxVersion = "TLSv1.3"
-)
-
-// Defines values for InputHttpTlsMinVersion.
-const (
-	InputHttpTlsMinVersionTLSv1  InputHttpTlsMinVersion = "TLSv1"
-	InputHttpTlsMinVersionTLSv11 InputHttpTlsMinVersion = "TLSv1.1"
-	InputHttpTlsMinVersionTLSv12 InputHttpTlsMinVersion = "TLSv1.2"
-	InputHttpTlsMinVersionTLSv13 InputHttpTlsMinVersion = "TLSv1.3"
-)
-
 // Defines values for InputHttpType.
 const (
 	InputHttpTypeHttp InputHttpType = "http"
 )
 
-// Defines values for InputHttpRawPqCompress.
-const (
-	InputHttpRawPqCompressGzip InputHttpRawPqCompress = "gzip"
-	InputHttpRawPqCompressNone InputHttpRawPqCompress = "none"
-)
-
-// Defines values for InputHttpRawPqMode.
-const (
-	InputHttpRawPqModeAlways InputHttpRawPqMode = "always"
-	InputHttpRawPqModeSmart  InputHttpRawPqMode = "smart"
-)
-
 // Defines values for InputHttpRawPqEnabled.
 const (
 	InputHttpRawPqEnabledFalse InputHttpRawPqEnabled = false
@@ -1500,28 +1424,6 @@ const (
 	InputHttpRawSendToRoutesTrue  InputHttpRawSendToRoutes = true
 )
 
-// Defines values for InputHttpRawTlsDisabled.
-const (
-	InputHttpRawTlsDisabledFalse InputHttpRawTlsDisabled = false
-	InputHttpRawTlsDisabledTrue  InputHttpRawTlsDisabled = true
-)
-
-// Defines values for InputHttpRawTlsMaxVersion.
-const (
-	InputHttpRawTlsMaxVersionTLSv1  InputHttpRawTlsMaxVersion = "TLSv1"
-	InputHttpRawTlsMaxVersionTLSv11 InputHttpRawTlsMaxVersion = "TLSv1.1"
-	InputHttpRawTlsMaxVersionTLSv12 InputHttpRawTlsMaxVersion = "TLSv1.2"
-	InputHttpRawTlsMaxVersionTLSv13 InputHttpRawTlsMaxVersion = "TLSv1.3"
-)
-
-// Defines values for InputHttpRawTlsMinVersion.
-const (
-	InputHttpRawTlsMinVersionTLSv1  InputHttpRawTlsMinVersion = "TLSv1"
-	InputHttpRawTlsMinVersionTLSv11 InputHttpRawTlsMinVersion = "TLSv1.1"
-	InputHttpRawTlsMinVersionTLSv12 InputHttpRawTlsMinVersion = "TLSv1.2"
-	InputHttpRawTlsMinVersionTLSv13 InputHttpRawTlsMinVersion = "TLSv1.3"
-)
-
 // Defines values for InputHttpRawType.
 const (
 	HttpRaw InputHttpRawType = "http_raw"
@@ -1551,100 +1453,18 @@ const (
 	InputJournalFilesSendToRoutesTrue  InputJournalFilesSendToRoutes = true
 )
 
-// Defines values for InputKafkaKafkaSchemaRegistryAuthDisabled.
-const (
-	InputKafkaKafkaSchemaRegistryAuthDisabledFalse InputKafkaKafkaSchemaRegistryAuthDisabled = false
-	InputKafkaKafkaSchemaRegistryAuthDisabledTrue  InputKafkaKafkaSchemaRegistryAuthDisabled = true
-)
-
-// Defines values for InputKafkaKafkaSchemaRegistryDisabled.
-const (
-	InputKafkaKafkaSchemaRegistryDisabledFalse InputKafkaKafkaSchemaRegistryDisabled = false
-	InputKafkaKafkaSchemaRegistryDisabledTrue  InputKafkaKafkaSchemaRegistryDisabled = true
-)
-
-// Defines values for InputKafkaKafkaSchemaRegistryTlsDisabled.
-const (
-	InputKafkaKafkaSchemaRegistryTlsDisabledFalse InputKafkaKafkaSchemaRegistryTlsDisabled = false
-	InputKafkaKafkaSchemaRegistryTlsDisabledTrue  InputKafkaKafkaSchemaRegistryTlsDisabled = true
-)
-
-// Defines values for InputKafkaKafkaSchemaRegistryTlsMaxVersion.
-const (
-	InputKafkaKafkaSchemaRegistryTlsMaxVersionTLSv1  InputKafkaKafkaSchemaRegistryTlsMaxVersion = "TLSv1"
-	InputKafkaKafkaSchemaRegistryTlsMaxVersionTLSv11 InputKafkaKafkaSchemaRegistryTlsMaxVersion = "TLSv1.1"
-	InputKafkaKafkaSchemaRegistryTlsMaxVersionTLSv12 InputKafkaKafkaSchemaRegistryTlsMaxVersion = "TLSv1.2"
-	InputKafkaKafkaSchemaRegistryTlsMaxVersionTLSv13 InputKafkaKafkaSchemaRegistryTlsMaxVersion = "TLSv1.3"
-)
-
-// Defines values for InputKafkaKafkaSchemaRegistryTlsMinVersion.
-const (
-	InputKafkaKafkaSchemaRegistryTlsMinVersionTLSv1  InputKafkaKafkaSchemaRegistryTlsMinVersion = "TLSv1"
-	InputKafkaKafkaSchemaRegistryTlsMinVersionTLSv11 InputKafkaKafkaSchemaRegistryTlsMinVersion = "TLSv1.1"
-	InputKafkaKafkaSchemaRegistryTlsMinVersionTLSv12 InputKafkaKafkaSchemaRegistryTlsMinVersion = "TLSv1.2"
-	InputKafkaKafkaSchemaRegistryTlsMinVersionTLSv13 InputKafkaKafkaSchemaRegistryTlsMinVersion = "TLSv1.3"
-)
-
-// Defines values for InputKafkaPqCompress.
-const (
-	InputKafkaPqCompressGzip InputKafkaPqCompress = "gzip"
-	InputKafkaPqCompressNone InputKafkaPqCompress = "none"
-)
-
-// Defines values for InputKafkaPqMode.
-const (
-	InputKafkaPqModeAlways InputKafkaPqMode = "always"
-	InputKafkaPqModeSmart  InputKafkaPqMode = "smart"
-)
-
 // Defines values for InputKafkaPqEnabled.
 const (
 	InputKafkaPqEnabledFalse InputKafkaPqEnabled = false
 	InputKafkaPqEnabledTrue  InputKafkaPqEnabled = true
 )
 
-// Defines values for InputKafkaSaslDisabled.
-const (
-	InputKafkaSaslDisabledFalse InputKafkaSaslDisabled = false
-	InputKafkaSaslDisabledTrue  InputKafkaSaslDisabled = true
-)
-
-// Defines values for InputKafkaSaslMechanism.
-const (
-	InputKafkaSaslMechanismKerberos    InputKafkaSaslMechanism = "kerberos"
-	InputKafkaSaslMechanismPlain       InputKafkaSaslMechanism = "plain"
-	InputKafkaSaslMechanismScramSha256 InputKafkaSaslMechanism = "scram-sha-256"
-	InputKafkaSaslMechanismScramSha512 InputKafkaSaslMechanism = "scram-sha-512"
-)
-
 // Defines values for InputKafkaSendToRoutes.
 const (
 	InputKafkaSendToRoutesFalse InputKafkaSendToRoutes = false
 	InputKafkaSendToRoutesTrue  InputKafkaSendToRoutes = true
 )
 
-// Defines values for InputKafkaTlsDisabled.
-const (
-	InputKafkaTlsDisabledFalse InputKafkaTlsDisabled = false
-	InputKafkaTlsDisabledTrue  InputKafkaTlsDisabled = true
-)
-
-// Defines values for InputKafkaTlsMaxVersion.
-const (
-	InputKafkaTlsMaxVersionTLSv1  InputKafkaTlsMaxVersion = "TLSv1"
-	InputKafkaTlsMaxVersionTLSv11 InputKafkaTlsMaxVersion = "TLSv1.1"
-	InputKafkaTlsMaxVersionTLSv12 InputKafkaTlsMaxVersion = "TLSv1.2"
-	InputKafkaTlsMaxVersionTLSv13 InputKafkaTlsMaxVersion = "TLSv1.3"
-)
-
-// Defines values for InputKafkaTlsMinVersion.
-const (
-	InputKafkaTlsMinVersionTLSv1  InputKafkaTlsMinVersion = "TLSv1"
-	InputKafkaTlsMinVersionTLSv11 InputKafkaTlsMinVersion = "TLSv1.1"
-	InputKafkaTlsMinVersionTLSv12 InputKafkaTlsMinVersion = "TLSv1.2"
-	InputKafkaTlsMinVersionTLSv13 InputKafkaTlsMinVersion = "TLSv1.3"
-)
-
 // Defines values for InputKafkaType.
 const (
 	InputKafkaTypeKafka InputKafkaType = "kafka"
@@ -2307,18 +2127,6 @@ const (
 	InputOpenTelemetryOtlpVersionN131  InputOpenTelemetryOtlpVersion = "1.3.1"
 )
 
-// Defines values for InputOpenTelemetryPqCompress.
-const (
-	InputOpenTelemetryPqCompressGzip InputOpenTelemetryPqCompress = "gzip"
-	InputOpenTelemetryPqCompressNone InputOpenTelemetryPqCompress = "none"
-)
-
-// Defines values for InputOpenTelemetryPqMode.
-const (
-	InputOpenTelemetryPqModeAlways InputOpenTelemetryPqMode = "always"
-	InputOpenTelemetryPqModeSmart  InputOpenTelemetryPqMode = "smart"
-)
-
 // Defines values for InputOpenTelemetryPqEnabled.
 const (
 	InputOpenTelemetryPqEnabledFalse InputOpenTelemetryPqEnabled = false
@@ -2337,28 +2145,6 @@ const (
 	InputOpenTelemetrySendToRoutesTrue  InputOpenTelemetrySendToRoutes = true
 )
 
-// Defines values for InputOpenTelemetryTlsDisabled.
-const (
-	InputOpenTelemetryTlsDisabledFalse InputOpenTelemetryTlsDisabled = false
-	InputOpenTelemetryTlsDisabledTrue  InputOpenTelemetryTlsDisabled = true
-)
-
-// Defines values for InputOpenTelemetryTlsMaxVersion.
-const (
-	InputOpenTelemetryTlsMaxVersionTLSv1  InputOpenTelemetryTlsMaxVersion = "TLSv1"
-	InputOpenTelemetryTlsMaxVersionTLSv11 InputOpenTelemetryTlsMaxVersion = "TLSv1.1"
-	InputOpenTelemetryTlsMaxVersionTLSv12 InputOpenTelemetryTlsMaxVersion = "TLSv1.2"
-	InputOpenTelemetryTlsMaxVersionTLSv13 InputOpenTelemetryTlsMaxVersion = "TLSv1.3"
-)
-
-// Defines values for InputOpenTelemetryTlsMinVersion.
-const (
-	InputOpenTelemetryTlsMinVersionTLSv1  InputOpenTelemetryTlsMinVersion = "TLSv1"
-	InputOpenTelemetryTlsMinVersionTLSv11 InputOpenTelemetryTlsMinVersion = "TLSv1.1"
-	InputOpenTelemetryTlsMinVersionTLSv12 InputOpenTelemetryTlsMinVersion = "TLSv1.2"
-	InputOpenTelemetryTlsMinVersionTLSv13 InputOpenTelemetryTlsMinVersion = "TLSv1.3"
-)
-
 // Defines values for InputOpenTelemetryType.
 const (
 	InputOpenTelemetryTypeOpenTelemetry InputOpenTelemetryType = "open_telemetry"
@@ -2537,36 +2323,12 @@ const (
 	InputS3AwsAuthenticationMethodSecret InputS3AwsAuthenticationMethod = "secret"
 )
 
-// Defines values for InputS3CheckpointingEnabled.
-const (
-	InputS3CheckpointingEnabledFalse InputS3CheckpointingEnabled = false
-	InputS3CheckpointingEnabledTrue  InputS3CheckpointingEnabled = true
-)
-
-// Defines values for InputS3PqCompress.
-const (
-	InputS3PqCompressGzip InputS3PqCompress = "gzip"
-	InputS3PqCompressNone InputS3PqCompress = "none"
-)
-
-// Defines values for InputS3PqMode.
-const (
-	InputS3PqModeAlways InputS3PqMode = "always"
-	InputS3PqModeSmart  InputS3PqMode = "smart"
-)
-
 // Defines values for InputS3PqEnabled.
 const (
 	InputS3PqEnabledFalse InputS3PqEnabled = false
 	InputS3PqEnabledTrue  InputS3PqEnabled = true
 )
 
-// Defines values for InputS3PreprocessDisabled.
-const (
-	InputS3PreprocessDisabledFalse InputS3PreprocessDisabled = false
-	InputS3PreprocessDisabledTrue  InputS3PreprocessDisabled = true
-)
-
 // Defines values for InputS3SendToRoutes.
 const (
 	InputS3SendToRoutesFalse InputS3SendToRoutes = false
@@ -2795,24 +2557,6 @@ const (
 	InputSplunkTypeSplunk InputSplunkType = "splunk"
 )
 
-// Defines values for InputSplunkHecAuthTokensAuthType.
-const (
-	InputSplunkHecAuthTokensAuthTypeManual InputSplunkHecAuthTokensAuthType = "manual"
-	InputSplunkHecAuthTokensAuthTypeSecret InputSplunkHecAuthTokensAuthType = "secret"
-)
-
-// Defines values for InputSplunkHecPqCompress.
-const (
-	InputSplunkHecPqCompressGzip InputSplunkHecPqCompress = "gzip"
-	InputSplunkHecPqCompressNone InputSplunkHecPqCompress = "none"
-)
-
-// Defines values for InputSplunkHecPqMode.
-const (
-	InputSplunkHecPqModeAlways InputSplunkHecPqMode = "always"
-	InputSplunkHecPqModeSmart  InputSplunkHecPqMode = "smart"
-)
-
 // Defines values for InputSplunkHecPqEnabled.
 const (
 	InputSplunkHecPqEnabledFalse InputSplunkHecPqEnabled = false
@@ -2825,28 +2569,6 @@ const (
 	InputSplunkHecSendToRoutesTrue  InputSplunkHecSendToRoutes = true
 )
 
-// Defines values for InputSplunkHecTlsDisabled.
-const (
-	InputSplunkHecTlsDisabledFalse InputSplunkHecTlsDisabled = false
-	InputSplunkHecTlsDisabledTrue  InputSplunkHecTlsDisabled = true
-)
-
-// Defines values for InputSplunkHecTlsMaxVersion.
-const (
-	InputSplunkHecTlsMaxVersionTLSv1  InputSplunkHecTlsMaxVersion = "TLSv1"
-	InputSplunkHecTlsMaxVersionTLSv11 InputSplunkHecTlsMaxVersion = "TLSv1.1"
-	InputSplunkHecTlsMaxVersionTLSv12 InputSplunkHecTlsMaxVersion = "TLSv1.2"
-	InputSplunkHecTlsMaxVersionTLSv13 InputSplunkHecTlsMaxVersion = "TLSv1.3"
-)
-
-// Defines values for InputSplunkHecTlsMinVersion.
-const (
-	InputSplunkHecTlsMinVersionTLSv1  InputSplunkHecTlsMinVersion = "TLSv1"
-	InputSplunkHecTlsMinVersionTLSv11 InputSplunkHecTlsMinVersion = "TLSv1.1"
-	InputSplunkHecTlsMinVersionTLSv12 InputSplunkHecTlsMinVersion = "TLSv1.2"
-	InputSplunkHecTlsMinVersionTLSv13 InputSplunkHecTlsMinVersion = "TLSv1.3"
-)
-
 // Defines values for InputSplunkHecType.
 const (
 	InputSplunkHecTypeSplunkHec InputSplunkHecType = "splunk_hec"
@@ -2919,18 +2641,6 @@ const (
 	InputSqsAwsAuthenticationMethodSecret InputSqsAwsAuthenticationMethod = "secret"
 )
 
-// Defines values for InputSqsPqCompress.
-const (
-	InputSqsPqCompressGzip InputSqsPqCompress = "gzip"
-	InputSqsPqCompressNone InputSqsPqCompress = "none"
-)
-
-// Defines values for InputSqsPqMode.
-const (
-	InputSqsPqModeAlways InputSqsPqMode = "always"
-	InputSqsPqModeSmart  InputSqsPqMode = "smart"
-)
-
 // Defines values for InputSqsPqEnabled.
 const (
 	InputSqsPqEnabledFalse InputSqsPqEnabled = false
@@ -2967,18 +2677,6 @@ const (
 	InputStatusStatusHealthYellow InputStatusStatusHealth = "Yellow"
 )
 
-// Defines values for InputSyslogPqCompress.
-const (
-	InputSyslogPqCompressGzip InputSyslogPqCompress = "gzip"
-	InputSyslogPqCompressNone InputSyslogPqCompress = "none"
-)
-
-// Defines values for InputSyslogPqMode.
-const (
-	InputSyslogPqModeAlways InputSyslogPqMode = "always"
-	InputSyslogPqModeSmart  InputSyslogPqMode = "smart"
-)
-
 // Defines values for InputSyslogPqEnabled.
 const (
 	InputSyslogPqEnabledFalse InputSyslogPqEnabled = false
@@ -2991,28 +2689,6 @@ const (
 	InputSyslogSendToRoutesTrue  InputSyslogSendToRoutes = true
 )
 
-// Defines values for InputSyslogTlsDisabled.
-const (
-	InputSyslogTlsDisabledFalse InputSyslogTlsDisabled = false
-	InputSyslogTlsDisabledTrue  InputSyslogTlsDisabled = true
-)
-
-// Defines values for InputSyslogTlsMaxVersion.
-const (
-	InputSyslogTlsMaxVersionTLSv1  InputSyslogTlsMaxVersion = "TLSv1"
-	InputSyslogTlsMaxVersionTLSv11 InputSyslogTlsMaxVersion = "TLSv1.1"
-	InputSyslogTlsMaxVersionTLSv12 InputSyslogTlsMaxVersion = "TLSv1.2"
-	InputSyslogTlsMaxVersionTLSv13 InputSyslogTlsMaxVersion = "TLSv1.3"
-)
-
-// Defines values for InputSyslogTlsMinVersion.
-const (
-	InputSyslogTlsMinVersionTLSv1  InputSyslogTlsMinVersion = "TLSv1"
-	InputSyslogTlsMinVersionTLSv11 InputSyslogTlsMinVersion = "TLSv1.1"
-	InputSyslogTlsMinVersionTLSv12 InputSyslogTlsMinVersion = "TLSv1.2"
-	InputSyslogTlsMinVersionTLSv13 InputSyslogTlsMinVersion = "TLSv1.3"
-)
-
 // Defines values for InputSyslogType.
 const (
 	InputSyslogTypeSyslog InputSyslogType = "syslog"
@@ -3320,8 +2996,8 @@ const (
 
 // Defines values for InputWefSubscriptionsQuerySelector.
 const (
-	InputWefSubscriptionsQuerySelectorSimple InputWefSubscriptionsQuerySelector = "simple"
-	InputWefSubscriptionsQuerySelectorXml    InputWefSubscriptionsQuerySelector = "xml"
+	Simple InputWefSubscriptionsQuerySelector = "simple"
+	Xml    InputWefSubscriptionsQuerySelector = "xml"
 )
 
 // Defines values for InputWefTlsMaxVersion.
@@ -4991,20 +4667,6 @@ const (
 	DynatraceOtlp OutputDynatraceOtlpType = "dynatrace_otlp"
 )
 
-// Defines values for OutputElasticAuthAuthType.
-const (
-	OutputElasticAuthAuthTypeManual       OutputElasticAuthAuthType = "manual"
-	OutputElasticAuthAuthTypeManualAPIKey OutputElasticAuthAuthType = "manualAPIKey"
-	OutputElasticAuthAuthTypeSecret       OutputElasticAuthAuthType = "secret"
-	OutputElasticAuthAuthTypeTextSecret   OutputElasticAuthAuthType = "textSecret"
-)
-
-// Defines values for OutputElasticAuthDisabled.
-const (
-	OutputElasticAuthDisabledFalse OutputElasticAuthDisabled = false
-	OutputElasticAuthDisabledTrue  OutputElasticAuthDisabled = true
-)
-
 // Defines values for OutputElasticElasticVersion.
 const (
 	OutputElasticElasticVersionAuto OutputElasticElasticVersion = "auto"
@@ -5051,12 +4713,6 @@ const (
 	OutputElasticPqOnBackpressureDrop  OutputElasticPqOnBackpressure = "drop"
 )
 
-// Defines values for OutputElasticTimeoutRetrySettingsTimeoutRetry.
-const (
-	OutputElasticTimeoutRetrySettingsTimeoutRetryFalse OutputElasticTimeoutRetrySettingsTimeoutRetry = false
-	OutputElasticTimeoutRetrySettingsTimeoutRetryTrue  OutputElasticTimeoutRetrySettingsTimeoutRetry = true
-)
-
 // Defines values for OutputElasticType.
 const (
 	OutputElasticTypeElastic OutputElasticType = "elastic"
@@ -5791,40 +5447,6 @@ const (
 	OutputKafkaFormatRaw      OutputKafkaFormat = "raw"
 )
 
-// Defines values for OutputKafkaKafkaSchemaRegistryAuthDisabled.
-const (
-	OutputKafkaKafkaSchemaRegistryAuthDisabledFalse OutputKafkaKafkaSchemaRegistryAuthDisabled = false
-	OutputKafkaKafkaSchemaRegistryAuthDisabledTrue  OutputKafkaKafkaSchemaRegistryAuthDisabled = true
-)
-
-// Defines values for OutputKafkaKafkaSchemaRegistryDisabled.
-const (
-	OutputKafkaKafkaSchemaRegistryDisabledFalse OutputKafkaKafkaSchemaRegistryDisabled = false
-	OutputKafkaKafkaSchemaRegistryDisabledTrue  OutputKafkaKafkaSchemaRegistryDisabled = true
-)
-
-// Defines values for OutputKafkaKafkaSchemaRegistryTlsDisabled.
-const (
-	OutputKafkaKafkaSchemaRegistryTlsDisabledFalse OutputKafkaKafkaSchemaRegistryTlsDisabled = false
-	OutputKafkaKafkaSchemaRegistryTlsDisabledTrue  OutputKafkaKafkaSchemaRegistryTlsDisabled = true
-)
-
-// Defines values for OutputKafkaKafkaSchemaRegistryTlsMaxVersion.
-const (
-	OutputKafkaKafkaSchemaRegistryTlsMaxVersionTLSv1  OutputKafkaKafkaSchemaRegistryTlsMaxVersion = "TLSv1"
-	OutputKafkaKafkaSchemaRegistryTlsMaxVersionTLSv11 OutputKafkaKafkaSchemaRegistryTlsMaxVersion = "TLSv1.1"
-	OutputKafkaKafkaSchemaRegistryTlsMaxVersionTLSv12 OutputKafkaKafkaSchemaRegistryTlsMaxVersion = "TLSv1.2"
-	OutputKafkaKafkaSchemaRegistryTlsMaxVersionTLSv13 OutputKafkaKafkaSchemaRegistryTlsMaxVersion = "TLSv1.3"
-)
-
-// Defines values for OutputKafkaKafkaSchemaRegistryTlsMinVersion.
-const (
-	OutputKafkaKafkaSchemaRegistryTlsMinVersionTLSv1  OutputKafkaKafkaSchemaRegistryTlsMinVersion = "TLSv1"
-	OutputKafkaKafkaSchemaRegistryTlsMinVersionTLSv11 OutputKafkaKafkaSchemaRegistryTlsMinVersion = "TLSv1.1"
-	OutputKafkaKafkaSchemaRegistryTlsMinVersionTLSv12 OutputKafkaKafkaSchemaRegistryTlsMinVersion = "TLSv1.2"
-	OutputKafkaKafkaSchemaRegistryTlsMinVersionTLSv13 OutputKafkaKafkaSchemaRegistryTlsMinVersion = "TLSv1.3"
-)
-
 // Defines values for OutputKafkaOnBackpressure.
 const (
 	OutputKafkaOnBackpressureBlock OutputKafkaOnBackpressure = "block"
@@ -5851,42 +5473,6 @@ const (
 	OutputKafkaPqOnBackpressureDrop  OutputKafkaPqOnBackpressure = "drop"
 )
 
-// Defines values for OutputKafkaSaslDisabled.
-const (
-	OutputKafkaSaslDisabledFalse OutputKafkaSaslDisabled = false
-	OutputKafkaSaslDisabledTrue  OutputKafkaSaslDisabled = true
-)
-
-// Defines values for OutputKafkaSaslMechanism.
-const (
-	Kerberos    OutputKafkaSaslMechanism = "kerberos"
-	Plain       OutputKafkaSaslMechanism = "plain"
-	ScramSha256 OutputKafkaSaslMechanism = "scram-sha-256"
-	ScramSha512 OutputKafkaSaslMechanism = "scram-sha-512"
-)
-
-// Defines values for OutputKafkaTlsDisabled.
-const (
-	OutputKafkaTlsDisabledFalse OutputKafkaTlsDisabled = false
-	OutputKafkaTlsDisabledTrue  OutputKafkaTlsDisabled = true
-)
-
-// Defines values for OutputKafkaTlsMaxVersion.
-const (
-	OutputKafkaTlsMaxVersionTLSv1  OutputKafkaTlsMaxVersion = "TLSv1"
-	OutputKafkaTlsMaxVersionTLSv11 OutputKafkaTlsMaxVersion = "TLSv1.1"
-	OutputKafkaTlsMaxVersionTLSv12 OutputKafkaTlsMaxVersion = "TLSv1.2"
-	OutputKafkaTlsMaxVersionTLSv13 OutputKafkaTlsMaxVersion = "TLSv1.3"
-)
-
-// Defines values for OutputKafkaTlsMinVersion.
-const (
-	OutputKafkaTlsMinVersionTLSv1  OutputKafkaTlsMinVersion = "TLSv1"
-	OutputKafkaTlsMinVersionTLSv11 OutputKafkaTlsMinVersion = "TLSv1.1"
-	OutputKafkaTlsMinVersionTLSv12 OutputKafkaTlsMinVersion = "TLSv1.2"
-	OutputKafkaTlsMinVersionTLSv13 OutputKafkaTlsMinVersion = "TLSv1.3"
-)
-
 // Defines values for OutputKafkaType.
 const (
 	OutputKafkaTypeKafka OutputKafkaType = "kafka"
@@ -6415,34 +6001,6 @@ const (
 	OutputOpenTelemetryProtocolHttp OutputOpenTelemetryProtocol = "http"
 )
 
-// Defines values for OutputOpenTelemetryTimeoutRetrySettingsTimeoutRetry.
-const (
-	OutputOpenTelemetryTimeoutRetrySettingsTimeoutRetryFalse OutputOpenTelemetryTimeoutRetrySettingsTimeoutRetry = false
-	OutputOpenTelemetryTimeoutRetrySettingsTimeoutRetryTrue  OutputOpenTelemetryTimeoutRetrySettingsTimeoutRetry = true
-)
-
-// Defines values for OutputOpenTelemetryTlsDisabled.
-const (
-	OutputOpenTelemetryTlsDisabledFalse OutputOpenTelemetryTlsDisabled = false
-	OutputOpenTelemetryTlsDisabledTrue  OutputOpenTelemetryTlsDisabled = true
-)
-
-// Defines values for OutputOpenTelemetryTlsMaxVersion.
-const (
-	OutputOpenTelemetryTlsMaxVersionTLSv1  OutputOpenTelemetryTlsMaxVersion = "TLSv1"
-	OutputOpenTelemetryTlsMaxVersionTLSv11 OutputOpenTelemetryTlsMaxVersion = "TLSv1.1"
-	OutputOpenTelemetryTlsMaxVersionTLSv12 OutputOpenTelemetryTlsMaxVersion = "TLSv1.2"
-	OutputOpenTelemetryTlsMaxVersionTLSv13 OutputOpenTelemetryTlsMaxVersion = "TLSv1.3"
-)
-
-// Defines values for OutputOpenTelemetryTlsMinVersion.
-const (
-	OutputOpenTelemetryTlsMinVersionTLSv1  OutputOpenTelemetryTlsMinVersion = "TLSv1"
-	OutputOpenTelemetryTlsMinVersionTLSv11 OutputOpenTelemetryTlsMinVersion = "TLSv1.1"
-	OutputOpenTelemetryTlsMinVersionTLSv12 OutputOpenTelemetryTlsMinVersion = "TLSv1.2"
-	OutputOpenTelemetryTlsMinVersionTLSv13 OutputOpenTelemetryTlsMinVersion = "TLSv1.3"
-)
-
 // Defines values for OutputOpenTelemetryType.
 const (
 	OutputOpenTelemetryTypeOpenTelemetry OutputOpenTelemetryType = "open_telemetry"
@@ -7114,30 +6672,12 @@ const (
 	OutputSplunkLbEnableACKTrue  OutputSplunkLbEnableACK = true
 )
 
-// Defines values for OutputSplunkLbHostsTls.
-const (
-	OutputSplunkLbHostsTlsInherit OutputSplunkLbHostsTls = "inherit"
-	OutputSplunkLbHostsTlsOff     OutputSplunkLbHostsTls = "off"
-)
-
 // Defines values for OutputSplunkLbIndexerDiscovery.
 const (
 	OutputSplunkLbIndexerDiscoveryFalse OutputSplunkLbIndexerDiscovery = false
 	OutputSplunkLbIndexerDiscoveryTrue  OutputSplunkLbIndexerDiscovery = true
 )
 
-// Defines values for OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthType.
-const (
-	OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthTypeManual OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthType = "manual"
-	OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthTypeSecret OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthType = "secret"
-)
-
-// Defines values for OutputSplunkLbIndexerDiscoveryConfigsAuthType.
-const (
-	OutputSplunkLbIndexerDiscoveryConfigsAuthTypeManual OutputSplunkLbIndexerDiscoveryConfigsAuthType = "manual"
-	OutputSplunkLbIndexerDiscoveryConfigsAuthTypeSecret OutputSplunkLbIndexerDiscoveryConfigsAuthType = "secret"
-)
-
 // Defines values for OutputSplunkLbMaxS2Sversion.
 const (
 	OutputSplunkLbMaxS2SversionV3 OutputSplunkLbMaxS2Sversion = "v3"
@@ -7176,28 +6716,6 @@ const (
 	OutputSplunkLbPqOnBackpressureDrop  OutputSplunkLbPqOnBackpressure = "drop"
 )
 
-// Defines values for OutputSplunkLbTlsDisabled.
-const (
-	OutputSplunkLbTlsDisabledFalse OutputSplunkLbTlsDisabled = false
-	OutputSplunkLbTlsDisabledTrue  OutputSplunkLbTlsDisabled = true
-)
-
-// Defines values for OutputSplunkLbTlsMaxVersion.
-const (
-	OutputSplunkLbTlsMaxVersionTLSv1  OutputSplunkLbTlsMaxVersion = "TLSv1"
-	OutputSplunkLbTlsMaxVersionTLSv11 OutputSplunkLbTlsMaxVersion = "TLSv1.1"
-	OutputSplunkLbTlsMaxVersionTLSv12 OutputSplunkLbTlsMaxVersion = "TLSv1.2"
-	OutputSplunkLbTlsMaxVersionTLSv13 OutputSplunkLbTlsMaxVersion = "TLSv1.3"
-)
-
-// Defines values for OutputSplunkLbTlsMinVersion.
-const (
-	OutputSplunkLbTlsMinVersionTLSv1  OutputSplunkLbTlsMinVersion = "TLSv1"
-	OutputSplunkLbTlsMinVersionTLSv11 OutputSplunkLbTlsMinVersion = "TLSv1.1"
-	OutputSplunkLbTlsMinVersionTLSv12 OutputSplunkLbTlsMinVersion = "TLSv1.2"
-	OutputSplunkLbTlsMinVersionTLSv13 OutputSplunkLbTlsMinVersion = "TLSv1.3"
-)
-
 // Defines values for OutputSplunkLbType.
 const (
 	SplunkLb OutputSplunkLbType = "splunk_lb"
@@ -7507,8 +7025,8 @@ const (
 
 // Defines values for OutputTcpjsonHostsTls.
 const (
-	Inherit OutputTcpjsonHostsTls = "inherit"
-	Off     OutputTcpjsonHostsTls = "off"
+	OutputTcpjsonHostsTlsInherit OutputTcpjsonHostsTls = "inherit"
+	OutputTcpjsonHostsTlsOff     OutputTcpjsonHostsTls = "off"
 )
 
 // Defines values for OutputTcpjsonLoadBalanced.
@@ -7684,34 +7202,6 @@ const (
 	Drop  OutputWebhookPqOnBackpressure = "drop"
 )
 
-// Defines values for OutputWebhookTimeoutRetrySettingsTimeoutRetry.
-const (
-	OutputWebhookTimeoutRetrySettingsTimeoutRetryFalse OutputWebhookTimeoutRetrySettingsTimeoutRetry = false
-	OutputWebhookTimeoutRetrySettingsTimeoutRetryTrue  OutputWebhookTimeoutRetrySettingsTimeoutRetry = true
-)
-
-// Defines values for OutputWebhookTlsDisabled.
-const (
-	OutputWebhookTlsDisabledFalse OutputWebhookTlsDisabled = false
-	OutputWebhookTlsDisabledTrue  OutputWebhookTlsDisabled = true
-)
-
-// Defines values for OutputWebhookTlsMaxVersion.
-const (
-	OutputWebhookTlsMaxVersionTLSv1  OutputWebhookTlsMaxVersion = "TLSv1"
-	OutputWebhookTlsMaxVersionTLSv11 OutputWebhookTlsMaxVersion = "TLSv1.1"
-	OutputWebhookTlsMaxVersionTLSv12 OutputWebhookTlsMaxVersion = "TLSv1.2"
-	OutputWebhookTlsMaxVersionTLSv13 OutputWebhookTlsMaxVersion = "TLSv1.3"
-)
-
-// Defines values for OutputWebhookTlsMinVersion.
-const (
-	OutputWebhookTlsMinVersionTLSv1  OutputWebhookTlsMinVersion = "TLSv1"
-	OutputWebhookTlsMinVersionTLSv11 OutputWebhookTlsMinVersion = "TLSv1.1"
-	OutputWebhookTlsMinVersionTLSv12 OutputWebhookTlsMinVersion = "TLSv1.2"
-	OutputWebhookTlsMinVersionTLSv13 OutputWebhookTlsMinVersion = "TLSv1.3"
-)
-
 // Defines values for OutputWebhookType.
 const (
 	DynatraceHttp OutputWebhookType = "dynatrace_http"
@@ -9395,61 +8885,13 @@ type EventBreakerRuleset struct {
 	MinRawLength *float32 `json:"minRawLength,omitempty"`
 
 	// Rules A list of rules that will be applied, in order, to the input data stream
-	Rules *[]struct {
-		// Condition The JavaScript filter expression used to match the data to apply the rule to
-		Condition string `json:"condition"`
-
-		// Disabled Disable this breaker rule (enabled by default)
-		Disabled *bool `json:"disabled,omitempty"`
-
-		// Fields Key-value pairs to be added to each event
-		Fields *[]struct {
-			Name *string `json:"name,omitempty"`
-
-			// Value The JavaScript expression used to compute the field's value (can be constant)
-			Value string `json:"value"`
-		} `json:"fields,omitempty"`
-
-		// MaxEventBytes The maximum number of bytes in an event before it is flushed to the pipelines
-		MaxEventBytes *float32 `json:"maxEventBytes,omitempty"`
-		Name          string   `json:"name"`
-		ParserEnabled *bool    `json:"parserEnabled,omitempty"`
-
-		// ShouldUseDataRaw Enable to set an internal field on events indicating that the field in the data called _raw should be used. This can be useful for post processors that want to use that field for event._raw, instead of replacing it with the actual raw event.
-		ShouldUseDataRaw *bool `json:"shouldUseDataRaw,omitempty"`
-
-		// Timestamp Auto, manual format (strptime), or current time
-		Timestamp struct {
-			Format *string                               `json:"format,omitempty"`
-			Length *float32                              `json:"length,omitempty"`
-			Type   EventBreakerRulesetRulesTimestampType `json:"type"`
-		} `json:"timestamp"`
-
-		// TimestampAnchorRegex The regex to match before attempting timestamp extraction. Use $ (end-of-string anchor) to prevent extraction.
-		TimestampAnchorRegex string `json:"timestampAnchorRegex"`
-
-		// TimestampEarliest The earliest timestamp value allowed relative to now. Example: -42years. Parsed values prior to this date will be set to current time.
-		TimestampEarliest *string `json:"timestampEarliest,omitempty"`
-
-		// TimestampLatest The latest timestamp value allowed relative to now. Example: +42days. Parsed values after this date will be set to current time.
-		TimestampLatest *string `json:"timestampLatest,omitempty"`
-
-		// TimestampTimezone Timezone to assign to timestamps without timezone info
-		TimestampTimezone *string                      `json:"timestampTimezone,omitempty"`
-		Type              EventBreakerRulesetRulesType `json:"type"`
-	} `json:"rules,omitempty"`
-	Tags *string `json:"tags,omitempty"`
+	Rules *[]EventBreakerRulesetRule `json:"rules,omitempty"`
+	Tags  *string                    `json:"tags,omitempty"`
 }
 
 // EventBreakerRulesetLib defines model for EventBreakerRuleset.Lib.
 type EventBreakerRulesetLib string
 
-// EventBreakerRulesetRulesTimestampType defines model for EventBreakerRuleset.Rules.Timestamp.Type.
-type EventBreakerRulesetRulesTimestampType string
-
-// EventBreakerRulesetRulesType defines model for EventBreakerRuleset.Rules.Type.
-type EventBreakerRulesetRulesType string
-
 // Executor defines model for Executor.
 type Executor = Function
 
@@ -9646,6 +9088,9 @@ type GitStatusResult struct {
 
 // GlobalVar defines model for GlobalVar.
 type GlobalVar struct {
+	// Args Arguments of an expression variable, referenced by name in its value. Optional.
+	Args *[]GlobalVarArg `json:"args,omitempty"`
+
 	// Description Brief description of this variable. Optional.
 	Description *string `json:"description,omitempty"`
 
@@ -11321,19 +10766,12 @@ type InputDatadogAgentTlsMinVersion string
 // InputDatadogAgentType defines model for InputDatadogAgent.Type.
 type InputDatadogAgentType string
 
-type InputConnection struct {
-		// Output Select a Destination.
-		Output string `json:"output"`
-		// Pipeline Select Pipeline or Pack. Optional.
-		Pipeline *string `json:"pipeline,omitempty"`
-}
-
 // InputDatagen defines model for InputDatagen.
 type InputDatagen struct {
 	// Connections Direct connections to Destinations, optionally via a Pipeline or a Pack.
 	Connections *[]InputConnection `json:"connections,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Disabled    *bool   `json:"disabled,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Disabled    *bool              `json:"disabled,omitempty"`
 
 	// Environment Optionally, enable this config only on a specified Git branch. If empty, will be enabled everywhere.
 	Environment *string `json:"environment,omitempty"`
@@ -11342,50 +10780,17 @@ type InputDatagen struct {
 	Id *string `json:"id,omitempty"`
 
 	// Metadata Fields to add to events from this input
-	Metadata *[]struct {
-		// Name Field name
-		Name string `json:"name"`
-
-		// Value JavaScript expression to compute field's value, enclosed in quotes or backticks. (Can evaluate to a constant.)
-		Value string `json:"value"`
-	} `json:"metadata,omitempty"`
+	Metadata *[]InputMetadata `json:"metadata,omitempty"`
 
 	// Pipeline Pipeline to process data from this Source before sending it through the Routes
-	Pipeline *string `json:"pipeline,omitempty"`
-	Pq       *struct {
-		// CommitFrequency The number of events to send downstream before committing that Stream has read them.
-		CommitFrequency *float32 `json:"commitFrequency,omitempty"`
-
-		// Compress Codec to use to compress the persisted data.
-		Compress *InputDatagenPqCompress `json:"compress,omitempty"`
-
-		// MaxBufferSize The maximum number of events to hold in memory before writing the events to disk.
-		MaxBufferSize *float32 `json:"maxBufferSize,omitempty"`
-
-		// MaxFileSize The maximum size to store in each queue file before closing and optionally compressing. Enter a numeral with units of KB, MB, etc.
-		MaxFileSize *string `json:"maxFileSize,omitempty"`
-
-		// MaxSize The maximum disk space that the queue can consume (as an average per Worker Process) before queueing stops. Enter a numeral with units of KB, MB, etc.
-		MaxSize *string `json:"maxSize,omitempty"`
-
-		// Mode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-		Mode *InputDatagenPqMode `json:"mode,omitempty"`
-
-		// Path The location for the persistent queue files. To this field's value, the system will append: /<worker-id>/inputs/<input-id>.
-		Path *string `json:"path,omitempty"`
-	} `json:"pq,omitempty"`
+	Pipeline *string  `json:"pipeline,omitempty"`
+	Pq       *InputPq `json:"pq,omitempty"`
 
 	// PqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 	PqEnabled *InputDatagenPqEnabled `json:"pqEnabled,omitempty"`
 
 	// Samples List of datagens
-	Samples []struct {
-		// EventsPerSec Maximum no. of events to generate per second per worker node. Defaults to 10.
-		EventsPerSec float32 `json:"eventsPerSec"`
-
-		// Sample Name of the datagen file
-		Sample string `json:"sample"`
-	} `json:"samples"`
+	Samples []InputDatagenSample `json:"samples"`
 
 	// SendToRoutes Select whether to send data to Routes, or directly to Destinations.
 	SendToRoutes *InputDatagenSendToRoutes `json:"sendToRoutes,omitempty"`
@@ -11395,12 +10800,6 @@ type InputDatagen struct {
 	Type       InputDatagenType `json:"type"`
 }
 
-// InputDatagenPqCompress Codec to use to compress the persisted data.
-type InputDatagenPqCompress string
-
-// InputDatagenPqMode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-type InputDatagenPqMode string
-
 // InputDatagenPqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 type InputDatagenPqEnabled bool
 
@@ -12849,33 +12248,13 @@ type InputHttp struct {
 	AuthTokens *[]string `json:"authTokens,omitempty"`
 
 	// AuthTokensExt Shared secrets to be provided by any client (Authorization: <token>). If empty, unauthorized access is permitted.
-	AuthTokensExt *[]struct {
-		Description *string `json:"description,omitempty"`
-
-		// Metadata Fields to add to events referencing this token
-		Metadata *[]struct {
-			// Name Field name
-			Name string `json:"name"`
-
-			// Value JavaScript expression to compute field's value, enclosed in quotes or backticks. (Can evaluate to a constant.)
-			Value string `json:"value"`
-		} `json:"metadata,omitempty"`
-
-		// Token Shared secret to be provided by any client (Authorization: <token>).
-		Token string `json:"token"`
-	} `json:"authTokensExt,omitempty"`
+	AuthTokensExt *[]InputAuthTokenExt `json:"authTokensExt,omitempty"`
 
 	// CaptureHeaders Toggle this to Yes to add request headers to events, in the __headers field.
 	CaptureHeaders *bool `json:"captureHeaders,omitempty"`
 
 	// Connections Direct connections to Destinations, optionally via a Pipeline or a Pack.
-	Connections *[]struct {
-		// Output Select a Destination.
-		Output string `json:"output"`
-
-		// Pipeline Select Pipeline or Pack. Optional.
-		Pipeline *string `json:"pipeline,omitempty"`
-	} `json:"connections,omitempty"`
+	Connections *[]InputConnection `json:"connections,omitempty"`
 
 	// CriblAPI Absolute path on which to listen for the Cribl HTTP API requests. At the moment, only _bulk (default /cribl/_bulk) is available. Use empty string to disable.
 	CriblAPI    *string `json:"criblAPI,omitempty"`
@@ -12916,41 +12295,14 @@ type InputHttp struct {
 	MaxRequestsPerSocket *int `json:"maxRequestsPerSocket,omitempty"`
 
 	// Metadata Fields to add to events from this input
-	Metadata *[]struct {
-		// Name Field name
-		Name string `json:"name"`
-
-		// Value JavaScript expression to compute field's value, enclosed in quotes or backticks. (Can evaluate to a constant.)
-		Value string `json:"value"`
-	} `json:"metadata,omitempty"`
+	Metadata *[]InputMetadata `json:"metadata,omitempty"`
 
 	// Pipeline Pipeline to process data from this Source before sending it through the Routes
 	Pipeline *string `json:"pipeline,omitempty"`
 
 	// Port Port to listen on.
-	Port float32 `json:"port"`
-	Pq   *struct {
-		// CommitFrequency The number of events to send downstream before committing that Stream has read them.
-		CommitFrequency *float32 `json:"commitFrequency,omitempty"`
-
-		// Compress Codec to use to compress the persisted data.
-		Compress *InputHttpPqCompress `json:"compress,omitempty"`
-
-		// MaxBufferSize The maximum number of events to hold in memory before writing the events to disk.
-		MaxBufferSize *float32 `json:"maxBufferSize,omitempty"`
-
-		// MaxFileSize The maximum size to store in each queue file before closing and optionally compressing. Enter a numeral with units of KB, MB, etc.
-		MaxFileSize *string `json:"maxFileSize,omitempty"`
-
-		// MaxSize The maximum disk space that the queue can consume (as an average per Worker Process) before queueing stops. Enter a numeral with units of KB, MB, etc.
-		MaxSize *string `json:"maxSize,omitempty"`
-
-		// Mode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-		Mode *InputHttpPqMode `json:"mode,omitempty"`
-
-		// Path The location for the persistent queue files. To this field's value, the system will append: /<worker-id>/inputs/<input-id>.
-		Path *string `json:"path,omitempty"`
-	} `json:"pq,omitempty"`
+	Port float32  `json:"port"`
+	Pq   *InputPq `json:"pq,omitempty"`
 
 	// PqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 	PqEnabled *InputHttpPqEnabled `json:"pqEnabled,omitempty"`
@@ -12969,59 +12321,17 @@ type InputHttp struct {
 	SplunkHecAcks *bool   `json:"splunkHecAcks,omitempty"`
 
 	// Streamtags Tags for filtering and grouping in @{product}
-	Streamtags *[]string `json:"streamtags,omitempty"`
-	Tls        *struct {
-		// CaPath Path on server containing CA certificates to use. PEM format. Can reference $ENV_VARS.
-		CaPath *string `json:"caPath,omitempty"`
-
-		// CertPath Path on server containing certificates to use. PEM format. Can reference $ENV_VARS.
-		CertPath *string `json:"certPath,omitempty"`
-
-		// CertificateName The name of the predefined certificate.
-		CertificateName *string               `json:"certificateName,omitempty"`
-		CommonNameRegex *interface{}          `json:"commonNameRegex,omitempty"`
-		Disabled        *InputHttpTlsDisabled `json:"disabled,omitempty"`
-
-		// MaxVersion Maximum TLS version to accept from connections
-		MaxVersion *InputHttpTlsMaxVersion `json:"maxVersion,omitempty"`
-
-		// MinVersion Minimum TLS version to accept from connections
-		MinVersion *InputHttpTlsMinVersion `json:"minVersion,omitempty"`
-
-		// Passphrase Passphrase to use to decrypt private key.
-		Passphrase *string `json:"passphrase,omitempty"`
-
-		// PrivKeyPath Path on server containing the private key to use. PEM format. Can reference $ENV_VARS.
-		PrivKeyPath        *string      `json:"privKeyPath,omitempty"`
-		RejectUnauthorized *interface{} `json:"rejectUnauthorized,omitempty"`
-
-		// RequestCert Whether to require clients to present their certificates. Used to perform client authentication using SSL certs.
-		RequestCert *bool `json:"requestCert,omitempty"`
-	} `json:"tls,omitempty"`
-	Type *InputHttpType `json:"type,omitempty"`
+	Streamtags *[]string           `json:"streamtags,omitempty"`
+	Tls        *InputTlsServerSide `json:"tls,omitempty"`
+	Type       *InputHttpType      `json:"type,omitempty"`
 }
 
-// InputHttpPqCompress Codec to use to compress the persisted data.
-type InputHttpPqCompress string
-
-// InputHttpPqMode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-type InputHttpPqMode string
-
 // InputHttpPqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 type InputHttpPqEnabled bool
 
 // InputHttpSendToRoutes Select whether to send data to Routes, or directly to Destinations.
 type InputHttpSendToRoutes bool
 
-// InputHttpTlsDisabled defines model for InputHttp.Tls.Disabled.
-type InputHttpTlsDisabled bool
-
-// InputHttpTlsMaxVersion Maximum TLS version to accept from connections
-type InputHttpTlsMaxVersion string
-
-// InputHttpTlsMinVersion Minimum TLS version to accept from connections
-type InputHttpTlsMinVersion string
-
 // InputHttpType defines model for InputHttp.Type.
 type InputHttpType string
 
@@ -13040,22 +12350,7 @@ type InputHttpRaw struct {
 	AuthTokens *[]string `json:"authTokens,omitempty"`
 
 	// AuthTokensExt Shared secrets to be provided by any client (Authorization: <token>). If empty, unauthorized access is permitted.
-	AuthTokensExt *[]struct {
-		// Description Optional token description
-		Description *string `json:"description,omitempty"`
-
-		// Metadata Fields to add to events referencing this token
-		Metadata *[]struct {
-			// Name Field name
-			Name string `json:"name"`
-
-			// Value JavaScript expression to compute field's value, enclosed in quotes or backticks. (Can evaluate to a constant.)
-			Value string `json:"value"`
-		} `json:"metadata,omitempty"`
-
-		// Token Shared secret to be provided by any client (Authorization: <token>)
-		Token string `json:"token"`
-	} `json:"authTokensExt,omitempty"`
+	AuthTokensExt *[]InputAuthTokenExt `json:"authTokensExt,omitempty"`
 
 	// BreakerRulesets A list of event-breaking rulesets that will be applied, in order, to the input data stream
 	BreakerRulesets *[]string `json:"breakerRulesets,omitempty"`
@@ -13064,15 +12359,9 @@ type InputHttpRaw struct {
 	CaptureHeaders *bool `json:"captureHeaders,omitempty"`
 
 	// Connections Direct connections to Destinations, optionally via a Pipeline or a Pack.
-	Connections *[]struct {
-		// Output Select a Destination.
-		Output string `json:"output"`
-
-		// Pipeline Select Pipeline or Pack. Optional.
-		Pipeline *string `json:"pipeline,omitempty"`
-	} `json:"connections,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Disabled    *bool   `json:"disabled,omitempty"`
+	Connections *[]InputConnection `json:"connections,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Disabled    *bool              `json:"disabled,omitempty"`
 
 	// EnableHealthCheck Enable to expose the /cribl_health endpoint, which returns 200 OK when this Source is healthy
 	EnableHealthCheck *bool `json:"enableHealthCheck,omitempty"`
@@ -13105,41 +12394,14 @@ type InputHttpRaw struct {
 	MaxRequestsPerSocket *int `json:"maxRequestsPerSocket,omitempty"`
 
 	// Metadata Fields to add to events from this input
-	Metadata *[]struct {
-		// Name Field name
-		Name string `json:"name"`
-
-		// Value JavaScript expression to compute field's value, enclosed in quotes or backticks. (Can evaluate to a constant.)
-		Value string `json:"value"`
-	} `json:"metadata,omitempty"`
+	Metadata *[]InputMetadata `json:"metadata,omitempty"`
 
 	// Pipeline Pipeline to process data from this Source before sending it through the Routes
 	Pipeline *string `json:"pipeline,omitempty"`
 
 	// Port Port to listen on.
-	Port float32 `json:"port"`
-	Pq   *struct {
-		// CommitFrequency The number of events to send downstream before committing that Stream has read them.
-		CommitFrequency *float32 `json:"commitFrequency,omitempty"`
-
-		// Compress Codec to use to compress the persisted data.
-		Compress *InputHttpRawPqCompress `json:"compress,omitempty"`
-
-		// MaxBufferSize The maximum number of events to hold in memory before writing the events to disk.
-		MaxBufferSize *float32 `json:"maxBufferSize,omitempty"`
-
-		// MaxFileSize The maximum size to store in each queue file before closing and optionally compressing. Enter a numeral with units of KB, MB, etc.
-		MaxFileSize *string `json:"maxFileSize,omitempty"`
-
-		// MaxSize The maximum disk space that the queue can consume (as an average per Worker Process) before queueing stops. Enter a numeral with units of KB, MB, etc.
-		MaxSize *string `json:"maxSize,omitempty"`
-
-		// Mode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-		Mode *InputHttpRawPqMode `json:"mode,omitempty"`
-
-		// Path The location for the persistent queue files. To this field's value, the system will append: /<worker-id>/inputs/<input-id>.
-		Path *string `json:"path,omitempty"`
-	} `json:"pq,omitempty"`
+	Port float32  `json:"port"`
+	Pq   *InputPq `json:"pq,omitempty"`
 
 	// PqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 	PqEnabled *InputHttpRawPqEnabled `json:"pqEnabled,omitempty"`
@@ -13157,59 +12419,17 @@ type InputHttpRaw struct {
 	StaleChannelFlushMs *float32 `json:"staleChannelFlushMs,omitempty"`
 
 	// Streamtags Tags for filtering and grouping in @{product}
-	Streamtags *[]string `json:"streamtags,omitempty"`
-	Tls        *struct {
-		// CaPath Path on server containing CA certificates to use. PEM format. Can reference $ENV_VARS.
-		CaPath *string `json:"caPath,omitempty"`
-
-		// CertPath Path on server containing certificates to use. PEM format. Can reference $ENV_VARS.
-		CertPath *string `json:"certPath,omitempty"`
-
-		// CertificateName The name of the predefined certificate.
-		CertificateName *string                  `json:"certificateName,omitempty"`
-		CommonNameRegex *interface{}             `json:"commonNameRegex,omitempty"`
-		Disabled        *InputHttpRawTlsDisabled `json:"disabled,omitempty"`
-
-		// MaxVersion Maximum TLS version to accept from connections
-		MaxVersion *InputHttpRawTlsMaxVersion `json:"maxVersion,omitempty"`
-
-		// MinVersion Minimum TLS version to accept from connections
-		MinVersion *InputHttpRawTlsMinVersion `json:"minVersion,omitempty"`
-
-		// Passphrase Passphrase to use to decrypt private key.
-		Passphrase *string `json:"passphrase,omitempty"`
-
-		// PrivKeyPath Path on server containing the private key to use. PEM format. Can reference $ENV_VARS.
-		PrivKeyPath        *string      `json:"privKeyPath,omitempty"`
-		RejectUnauthorized *interface{} `json:"rejectUnauthorized,omitempty"`
-
-		// RequestCert Whether to require clients to present their certificates. Used to perform client authentication using SSL certs.
-		RequestCert *bool `json:"requestCert,omitempty"`
-	} `json:"tls,omitempty"`
-	Type *InputHttpRawType `json:"type,omitempty"`
+	Streamtags *[]string           `json:"streamtags,omitempty"`
+	Tls        *InputTlsServerSide `json:"tls,omitempty"`
+	Type       *InputHttpRawType   `json:"type,omitempty"`
 }
 
-// InputHttpRawPqCompress Codec to use to compress the persisted data.
-type InputHttpRawPqCompress string
-
-// InputHttpRawPqMode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-type InputHttpRawPqMode string
-
 // InputHttpRawPqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 type InputHttpRawPqEnabled bool
 
 // InputHttpRawSendToRoutes Select whether to send data to Routes, or directly to Destinations.
 type InputHttpRawSendToRoutes bool
 
-// InputHttpRawTlsDisabled defines model for InputHttpRaw.Tls.Disabled.
-type InputHttpRawTlsDisabled bool
-
-// InputHttpRawTlsMaxVersion Maximum TLS version to accept from connections
-type InputHttpRawTlsMaxVersion string
-
-// InputHttpRawTlsMinVersion Minimum TLS version to accept from connections
-type InputHttpRawTlsMinVersion string
-
 // InputHttpRawType defines model for InputHttpRaw.Type.
 type InputHttpRawType string
 
@@ -13334,15 +12554,9 @@ type InputKafka struct {
 	ConnectionTimeout *float32 `json:"connectionTimeout,omitempty"`
 
 	// Connections Direct connections to Destinations, optionally via a Pipeline or a Pack.
-	Connections *[]struct {
-		// Output Select a Destination.
-		Output string `json:"output"`
-
-		// Pipeline Select Pipeline or Pack. Optional.
-		Pipeline *string `json:"pipeline,omitempty"`
-	} `json:"connections,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Disabled    *bool   `json:"disabled,omitempty"`
+	Connections *[]InputConnection `json:"connections,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Disabled    *bool              `json:"disabled,omitempty"`
 
 	// Environment Optionally, enable this config only on a specified Git branch. If empty, will be enabled everywhere.
 	Environment *string `json:"environment,omitempty"`
@@ -13363,62 +12577,8 @@ type InputKafka struct {
 	Id *string `json:"id,omitempty"`
 
 	// InitialBackoff Initial value used to calculate the retry, in milliseconds. Maximum is 600,000 ms (10 minutes).
-	InitialBackoff      *float32 `json:"initialBackoff,omitempty"`
-	KafkaSchemaRegistry *struct {
-		// Auth Credentials to use when authenticating with the schema registry using basic HTTP authentication
-		Auth *struct {
-			// CredentialsSecret Select or create a secret that references your credentials
-			CredentialsSecret *string `json:"credentialsSecret,omitempty"`
-
-			// Disabled Enable authentication
-			Disabled InputKafkaKafkaSchemaRegistryAuthDisabled `json:"disabled"`
-		} `json:"auth,omitempty"`
-
-		// ConnectionTimeout Maximum time to wait for a Schema Registry connection to complete successfully
-		ConnectionTimeout *float32 `json:"connectionTimeout,omitempty"`
-
-		// Disabled Enable Schema Registry
-		Disabled InputKafkaKafkaSchemaRegistryDisabled `json:"disabled"`
-
-		// MaxRetries Maximum number of times to try fetching schemas from the Schema Registry
-		MaxRetries *float32 `json:"maxRetries,omitempty"`
-
-		// RequestTimeout Maximum time to wait for the Schema Registry to respond to a request
-		RequestTimeout *float32 `json:"requestTimeout,omitempty"`
-
-		// SchemaRegistryURL URL for accessing the Confluent Schema Registry. Example: http://localhost:8081. To connect over TLS, use https instead of http.
-		SchemaRegistryURL *string `json:"schemaRegistryURL,omitempty"`
-		Tls               *struct {
-			// CaPath Path on client in which to find CA certificates to verify the server's cert. PEM format. Can reference $ENV_VARS.
-			CaPath *string `json:"caPath,omitempty"`
-
-			// CertPath Path on client in which to find certificates to use. PEM format. Can reference $ENV_VARS.
-			CertPath *string `json:"certPath,omitempty"`
-
-			// CertificateName The name of the predefined certificate.
-			CertificateName *string                                   `json:"certificateName,omitempty"`
-			Disabled        *InputKafkaKafkaSchemaRegistryTlsDisabled `json:"disabled,omitempty"`
-
-			// MaxVersion Maximum TLS version to use when connecting
-			MaxVersion *InputKafkaKafkaSchemaRegistryTlsMaxVersion `json:"maxVersion,omitempty"`
-
-			// MinVersion Minimum TLS version to use when connecting
-			MinVersion *InputKafkaKafkaSchemaRegistryTlsMinVersion `json:"minVersion,omitempty"`
-
-			// Passphrase Passphrase to use to decrypt private key.
-			Passphrase *string `json:"passphrase,omitempty"`
-
-			// PrivKeyPath Path on client in which to find the private key to use. PEM format. Can reference $ENV_VARS.
-			PrivKeyPath *string `json:"privKeyPath,omitempty"`
-
-			// RejectUnauthorized Reject certs that are not authorized by a CA in the CA certificate path, or by another
-			//                     trusted CA (e.g., the system's CA). Defaults to Yes. Overrides the toggle from Advanced Settings, when also present.
-			RejectUnauthorized *bool `json:"rejectUnauthorized,omitempty"`
-
-			// Servername Server name for the SNI (Server Name Indication) TLS extension. It must be a host name, and not an IP address.
-			Servername *string `json:"servername,omitempty"`
-		} `json:"tls,omitempty"`
-	} `json:"kafkaSchemaRegistry,omitempty"`
+	InitialBackoff      *float32             `json:"initialBackoff,omitempty"`
+	KafkaSchemaRegistry *KafkaSchemaRegistry `json:"kafkaSchemaRegistry,omitempty"`
 
 	// MaxBackOff The maximum wait time for a retry, in milliseconds. Default (and minimum) is 30,000 ms (30 seconds); maximum is 180,000 ms (180 seconds).
 	MaxBackOff *float32 `json:"maxBackOff,omitempty"`
@@ -13436,38 +12596,11 @@ type InputKafka struct {
 	MaxSocketErrors *float32 `json:"maxSocketErrors,omitempty"`
 
 	// Metadata Fields to add to events from this input
-	Metadata *[]struct {
-		// Name Field name
-		Name string `json:"name"`
-
-		// Value JavaScript expression to compute field's value, enclosed in quotes or backticks. (Can evaluate to a constant.)
-		Value string `json:"value"`
-	} `json:"metadata,omitempty"`
+	Metadata *[]InputMetadata `json:"metadata,omitempty"`
 
 	// Pipeline Pipeline to process data from this Source before sending it through the Routes
-	Pipeline *string `json:"pipeline,omitempty"`
-	Pq       *struct {
-		// CommitFrequency The number of events to send downstream before committing that Stream has read them.
-		CommitFrequency *float32 `json:"commitFrequency,omitempty"`
-
-		// Compress Codec to use to compress the persisted data.
-		Compress *InputKafkaPqCompress `json:"compress,omitempty"`
-
-		// MaxBufferSize The maximum number of events to hold in memory before writing the events to disk.
-		MaxBufferSize *float32 `json:"maxBufferSize,omitempty"`
-
-		// MaxFileSize The maximum size to store in each queue file before closing and optionally compressing. Enter a numeral with units of KB, MB, etc.
-		MaxFileSize *string `json:"maxFileSize,omitempty"`
-
-		// MaxSize The maximum disk space that the queue can consume (as an average per Worker Process) before queueing stops. Enter a numeral with units of KB, MB, etc.
-		MaxSize *string `json:"maxSize,omitempty"`
-
-		// Mode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-		Mode *InputKafkaPqMode `json:"mode,omitempty"`
-
-		// Path The location for the persistent queue files. To this field's value, the system will append: /<worker-id>/inputs/<input-id>.
-		Path *string `json:"path,omitempty"`
-	} `json:"pq,omitempty"`
+	Pipeline *string  `json:"pipeline,omitempty"`
+	Pq       *InputPq `json:"pq,omitempty"`
 
 	// PqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 	PqEnabled *InputKafkaPqEnabled `json:"pqEnabled,omitempty"`
@@ -13485,13 +12618,7 @@ type InputKafka struct {
 	RequestTimeout *float32 `json:"requestTimeout,omitempty"`
 
 	// Sasl Authentication parameters to use when connecting to brokers. Using TLS is highly recommended.
-	Sasl *struct {
-		// Disabled Enable Authentication
-		Disabled InputKafkaSaslDisabled `json:"disabled"`
-
-		// Mechanism SASL authentication mechanism to use.
-		Mechanism *InputKafkaSaslMechanism `json:"mechanism,omitempty"`
-	} `json:"sasl,omitempty"`
+	Sasl *KafkaSasl `json:"sasl,omitempty"`
 
 	// SendToRoutes Select whether to send data to Routes, or directly to Destinations.
 	SendToRoutes *InputKafkaSendToRoutes `json:"sendToRoutes,omitempty"`
@@ -13505,85 +12632,20 @@ type InputKafka struct {
 	SessionTimeout *float32 `json:"sessionTimeout,omitempty"`
 
 	// Streamtags Tags for filtering and grouping in @{product}
-	Streamtags *[]string `json:"streamtags,omitempty"`
-	Tls        *struct {
-		// CaPath Path on client in which to find CA certificates to verify the server's cert. PEM format. Can reference $ENV_VARS.
-		CaPath *string `json:"caPath,omitempty"`
-
-		// CertPath Path on client in which to find certificates to use. PEM format. Can reference $ENV_VARS.
-		CertPath *string `json:"certPath,omitempty"`
-
-		// CertificateName The name of the predefined certificate.
-		CertificateName *string                `json:"certificateName,omitempty"`
-		Disabled        *InputKafkaTlsDisabled `json:"disabled,omitempty"`
-
-		// MaxVersion Maximum TLS version to use when connecting
-		MaxVersion *InputKafkaTlsMaxVersion `json:"maxVersion,omitempty"`
-
-		// MinVersion Minimum TLS version to use when connecting
-		MinVersion *InputKafkaTlsMinVersion `json:"minVersion,omitempty"`
-
-		// Passphrase Passphrase to use to decrypt private key.
-		Passphrase *string `json:"passphrase,omitempty"`
-
-		// PrivKeyPath Path on client in which to find the private key to use. PEM format. Can reference $ENV_VARS.
-		PrivKeyPath *string `json:"privKeyPath,omitempty"`
-
-		// RejectUnauthorized Reject certs that are not authorized by a CA in the CA certificate path, or by another
-		//                     trusted CA (e.g., the system's CA). Defaults to Yes. Overrides the toggle from Advanced Settings, when also present.
-		RejectUnauthorized *bool `json:"rejectUnauthorized,omitempty"`
-
-		// Servername Server name for the SNI (Server Name Indication) TLS extension. It must be a host name, and not an IP address.
-		Servername *string `json:"servername,omitempty"`
-	} `json:"tls,omitempty"`
+	Streamtags *[]string            `json:"streamtags,omitempty"`
+	Tls        *OutputTlsClientSide `json:"tls,omitempty"`
 
 	// Topics Topic to subscribe to. Warning: To optimize performance, Cribl suggests subscribing each Kafka Source to only a single topic.
 	Topics []string        `json:"topics"`
 	Type   *InputKafkaType `json:"type,omitempty"`
 }
 
-// InputKafkaKafkaSchemaRegistryAuthDisabled Enable authentication
-type InputKafkaKafkaSchemaRegistryAuthDisabled bool
-
-// InputKafkaKafkaSchemaRegistryDisabled Enable Schema Registry
-type InputKafkaKafkaSchemaRegistryDisabled bool
-
-// InputKafkaKafkaSchemaRegistryTlsDisabled defines model for InputKafka.KafkaSchemaRegistry.Tls.Disabled.
-type InputKafkaKafkaSchemaRegistryTlsDisabled bool
-
-// InputKafkaKafkaSchemaRegistryTlsMaxVersion Maximum TLS version to use when connecting
-type InputKafkaKafkaSchemaRegistryTlsMaxVersion string
-
-// InputKafkaKafkaSchemaRegistryTlsMinVersion Minimum TLS version to use when connecting
-type InputKafkaKafkaSchemaRegistryTlsMinVersion string
-
-// InputKafkaPqCompress Codec to use to compress the persisted data.
-type InputKafkaPqCompress string
-
-// InputKafkaPqMode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-type InputKafkaPqMode string
-
 // InputKafkaPqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 type InputKafkaPqEnabled bool
 
-// InputKafkaSaslDisabled Enable Authentication
-type InputKafkaSaslDisabled bool
-
-// InputKafkaSaslMechanism SASL authentication mechanism to use.
-type InputKafkaSaslMechanism string
-
 // InputKafkaSendToRoutes Select whether to send data to Routes, or directly to Destinations.
 type InputKafkaSendToRoutes bool
 
-// InputKafkaTlsDisabled defines model for InputKafka.Tls.Disabled.
-type InputKafkaTlsDisabled bool
-
-// InputKafkaTlsMaxVersion Maximum TLS version to use when connecting
-type InputKafkaTlsMaxVersion string
-
-// InputKafkaTlsMinVersion Minimum TLS version to use when connecting
-type InputKafkaTlsMinVersion string
-
 // InputKafkaType defines model for InputKafka.Type.
 type InputKafkaType string
 
@@ -15509,13 +14571,7 @@ type InputOpenTelemetry struct {
 	CaptureHeaders *interface{}                `json:"captureHeaders,omitempty"`
 
 	// Connections Direct connections to Destinations, optionally via a Pipeline or a Pack.
-	Connections *[]struct {
-		// Output Select a Destination.
-		Output string `json:"output"`
-
-		// Pipeline Select Pipeline or Pack. Optional.
-		Pipeline *string `json:"pipeline,omitempty"`
-	} `json:"connections,omitempty"`
+	Connections *[]InputConnection `json:"connections,omitempty"`
 
 	// CredentialsSecret Select or create a secret that references your credentials
 	CredentialsSecret *string `json:"credentialsSecret,omitempty"`
@@ -15566,31 +14622,13 @@ type InputOpenTelemetry struct {
 	MaxRequestsPerSocket *int `json:"maxRequestsPerSocket,omitempty"`
 
 	// Metadata Fields to add to events from this input
-	Metadata *[]struct {
-		// Name Field name
-		Name string `json:"name"`
-
-		// Value JavaScript expression to compute field's value, enclosed in quotes or backticks. (Can evaluate to a constant.)
-		Value string `json:"value"`
-	} `json:"metadata,omitempty"`
+	Metadata *[]InputMetadata `json:"metadata,omitempty"`
 
 	// OauthHeaders Additional headers to send in the OAuth login request. @{product} will automatically add the content-type header 'application/x-www-form-urlencoded' when sending this request.
-	OauthHeaders *[]struct {
-		// Name OAuth header name
-		Name string `json:"name"`
-
-		// Value OAuth header value
-		Value string `json:"value"`
-	} `json:"oauthHeaders,omitempty"`
+	OauthHeaders *[]OauthHeader `json:"oauthHeaders,omitempty"`
 
 	// OauthParams Additional parameters to send in the OAuth login request. @{product} will combine the secret with these parameters, and will send the URL-encoded result in a POST request to the endpoint specified in the 'Login URL'. We'll automatically add the content-type header 'application/x-www-form-urlencoded' when sending this request.
-	OauthParams *[]struct {
-		// Name OAuth parameter name
-		Name string `json:"name"`
-
-		// Value OAuth parameter value
-		Value string `json:"value"`
-	} `json:"oauthParams,omitempty"`
+	OauthParams *[]OauthParam `json:"oauthParams,omitempty"`
 
 	// OtlpVersion The version of OTLP Protobuf definitions to use when interpreting received data
 	OtlpVersion *InputOpenTelemetryOtlpVersion `json:"otlpVersion,omitempty"`
@@ -15602,29 +14640,8 @@ type InputOpenTelemetry struct {
 	Pipeline *string `json:"pipeline,omitempty"`
 
 	// Port Port to listen on.
-	Port float32 `json:"port"`
-	Pq   *struct {
-		// CommitFrequency The number of events to send downstream before committing that Stream has read them.
-		CommitFrequency *float32 `json:"commitFrequency,omitempty"`
-
-		// Compress Codec to use to compress the persisted data.
-		Compress *InputOpenTelemetryPqCompress `json:"compress,omitempty"`
-
-		// MaxBufferSize The maximum number of events to hold in memory before writing the events to disk.
-		MaxBufferSize *float32 `json:"maxBufferSize,omitempty"`
-
-		// MaxFileSize The maximum size to store in each queue file before closing and optionally compressing. Enter a numeral with units of KB, MB, etc.
-		MaxFileSize *string `json:"maxFileSize,omitempty"`
-
-		// MaxSize The maximum disk space that the queue can consume (as an average per Worker Process) before queueing stops. Enter a numeral with units of KB, MB, etc.
-		MaxSize *string `json:"maxSize,omitempty"`
-
-		// Mode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-		Mode *InputOpenTelemetryPqMode `json:"mode,omitempty"`
-
-		// Path The location for the persistent queue files. To this field's value, the system will append: /<worker-id>/inputs/<input-id>.
-		Path *string `json:"path,omitempty"`
-	} `json:"pq,omitempty"`
+	Port float32  `json:"port"`
+	Pq   *InputPq `json:"pq,omitempty"`
 
 	// PqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 	PqEnabled *InputOpenTelemetryPqEnabled `json:"pqEnabled,omitempty"`
@@ -15652,35 +14669,8 @@ type InputOpenTelemetry struct {
 	Streamtags *[]string `json:"streamtags,omitempty"`
 
 	// TextSecret Select or create a stored text secret
-	TextSecret *string `json:"textSecret,omitempty"`
-	Tls        *struct {
-		// CaPath Path on server containing CA certificates to use. PEM format. Can reference $ENV_VARS.
-		CaPath *string `json:"caPath,omitempty"`
-
-		// CertPath Path on server containing certificates to use. PEM format. Can reference $ENV_VARS.
-		CertPath *string `json:"certPath,omitempty"`
-
-		// CertificateName The name of the predefined certificate.
-		CertificateName *string                        `json:"certificateName,omitempty"`
-		CommonNameRegex *interface{}                   `json:"commonNameRegex,omitempty"`
-		Disabled        *InputOpenTelemetryTlsDisabled `json:"disabled,omitempty"`
-
-		// MaxVersion Maximum TLS version to accept from connections
-		MaxVersion *InputOpenTelemetryTlsMaxVersion `json:"maxVersion,omitempty"`
-
-		// MinVersion Minimum TLS version to accept from connections
-		MinVersion *InputOpenTelemetryTlsMinVersion `json:"minVersion,omitempty"`
-
-		// Passphrase Passphrase to use to decrypt private key.
-		Passphrase *string `json:"passphrase,omitempty"`
-
-		// PrivKeyPath Path on server containing the private key to use. PEM format. Can reference $ENV_VARS.
-		PrivKeyPath        *string      `json:"privKeyPath,omitempty"`
-		RejectUnauthorized *interface{} `json:"rejectUnauthorized,omitempty"`
-
-		// RequestCert Whether to require clients to present their certificates. Used to perform client authentication using SSL certs.
-		RequestCert *bool `json:"requestCert,omitempty"`
-	} `json:"tls,omitempty"`
+	TextSecret *string             `json:"textSecret,omitempty"`
+	Tls        *InputTlsServerSide `json:"tls,omitempty"`
 
 	// Token Bearer token to include in the authorization header
 	Token *string `json:"token,omitempty"`
@@ -15702,12 +14692,6 @@ type InputOpenTelemetryAuthType string
 // InputOpenTelemetryOtlpVersion The version of OTLP Protobuf definitions to use when interpreting received data
 type InputOpenTelemetryOtlpVersion string
 
-// InputOpenTelemetryPqCompress Codec to use to compress the persisted data.
-type InputOpenTelemetryPqCompress string
-
-// InputOpenTelemetryPqMode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-type InputOpenTelemetryPqMode string
-
 // InputOpenTelemetryPqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 type InputOpenTelemetryPqEnabled bool
 
@@ -15717,15 +14701,6 @@ type InputOpenTelemetryProtocol string
 // InputOpenTelemetrySendToRoutes Select whether to send data to Routes, or directly to Destinations.
 type InputOpenTelemetrySendToRoutes bool
 
-// InputOpenTelemetryTlsDisabled defines model for InputOpenTelemetry.Tls.Disabled.
-type InputOpenTelemetryTlsDisabled bool
-
-// InputOpenTelemetryTlsMaxVersion Maximum TLS version to accept from connections
-type InputOpenTelemetryTlsMaxVersion string
-
-// InputOpenTelemetryTlsMinVersion Minimum TLS version to accept from connections
-type InputOpenTelemetryTlsMinVersion string
-
 // InputOpenTelemetryType defines model for InputOpenTelemetry.Type.
 type InputOpenTelemetryType string
 
@@ -16268,25 +15243,13 @@ type InputS3 struct {
 	AwsSecretKey *string `json:"awsSecretKey,omitempty"`
 
 	// BreakerRulesets A list of event-breaking rulesets that will be applied, in order, to the input data stream
-	BreakerRulesets *[]string `json:"breakerRulesets,omitempty"`
-	Checkpointing   *struct {
-		// Enabled Enable checkpointing to resume processing files after an interruption.
-		Enabled InputS3CheckpointingEnabled `json:"enabled"`
-
-		// Retries If checkpointing is enabled, the number of times to retry processing when a processing error occurs. If skip file on error is enabled, this setting is ignored.
-		Retries *float32 `json:"retries,omitempty"`
-	} `json:"checkpointing,omitempty"`
+	BreakerRulesets *[]string             `json:"breakerRulesets,omitempty"`
+	Checkpointing   *InputS3Checkpointing `json:"checkpointing,omitempty"`
 
 	// Connections Direct connections to Destinations, optionally via a Pipeline or a Pack.
-	Connections *[]struct {
-		// Output Select a Destination.
-		Output string `json:"output"`
-
-		// Pipeline Select Pipeline or Pack. Optional.
-		Pipeline *string `json:"pipeline,omitempty"`
-	} `json:"connections,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Disabled    *bool   `json:"disabled,omitempty"`
+	Connections *[]InputConnection `json:"connections,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Disabled    *bool              `json:"disabled,omitempty"`
 
 	// DurationSeconds Duration of the assumed role's session, in seconds. Minimum is 900 (15 minutes), default is 3600 (1 hour), and maximum is 43200 (12 hours).
 	DurationSeconds *float32 `json:"durationSeconds,omitempty"`
@@ -16316,13 +15279,7 @@ type InputS3 struct {
 	MaxMessages *float32 `json:"maxMessages,omitempty"`
 
 	// Metadata Fields to add to events from this input
-	Metadata *[]struct {
-		// Name Field name
-		Name string `json:"name"`
-
-		// Value JavaScript expression to compute field's value, enclosed in quotes or backticks. (Can evaluate to a constant.)
-		Value string `json:"value"`
-	} `json:"metadata,omitempty"`
+	Metadata *[]InputMetadata `json:"metadata,omitempty"`
 
 	// NumReceivers The Number of receiver processes to run, the higher the number the better throughput at the expense of CPU overhead
 	NumReceivers *float32 `json:"numReceivers,omitempty"`
@@ -16338,41 +15295,11 @@ type InputS3 struct {
 
 	// PollTimeout The amount of time to wait for events before trying polling again. The lower the number the higher the AWS bill. The higher the number the longer it will take for the source to react to configuration changes and system restarts.
 	PollTimeout *float32 `json:"pollTimeout,omitempty"`
-	Pq          *struct {
-		// CommitFrequency The number of events to send downstream before committing that Stream has read them.
-		CommitFrequency *float32 `json:"commitFrequency,omitempty"`
-
-		// Compress Codec to use to compress the persisted data.
-		Compress *InputS3PqCompress `json:"compress,omitempty"`
-
-		// MaxBufferSize The maximum number of events to hold in memory before writing the events to disk.
-		MaxBufferSize *float32 `json:"maxBufferSize,omitempty"`
-
-		// MaxFileSize The maximum size to store in each queue file before closing and optionally compressing. Enter a numeral with units of KB, MB, etc.
-		MaxFileSize *string `json:"maxFileSize,omitempty"`
-
-		// MaxSize The maximum disk space that the queue can consume (as an average per Worker Process) before queueing stops. Enter a numeral with units of KB, MB, etc.
-		MaxSize *string `json:"maxSize,omitempty"`
-
-		// Mode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-		Mode *InputS3PqMode `json:"mode,omitempty"`
-
-		// Path The location for the persistent queue files. To this field's value, the system will append: /<worker-id>/inputs/<input-id>.
-		Path *string `json:"path,omitempty"`
-	} `json:"pq,omitempty"`
+	Pq          *InputPq `json:"pq,omitempty"`
 
 	// PqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 	PqEnabled  *InputS3PqEnabled `json:"pqEnabled,omitempty"`
-	Preprocess *struct {
-		// Args Arguments to be added to the custom command
-		Args *[]string `json:"args,omitempty"`
-
-		// Command Command to feed the data through (via stdin) and process its output (stdout)
-		Command *string `json:"command,omitempty"`
-
-		// Disabled Enable Custom Command
-		Disabled InputS3PreprocessDisabled `json:"disabled"`
-	} `json:"preprocess,omitempty"`
+	Preprocess *InputPreprocess  `json:"preprocess,omitempty"`
 
 	// QueueName The name, URL, or ARN of the SQS queue to read notifications from. When a non-AWS URL is specified, format must be: '{url}/myQueueName'. E.g., 'https://host:port/myQueueName'. Value must be a JavaScript expression (which can evaluate to a constant value), enclosed in quotes or backticks. Can be evaluated only at init time. E.g., referencing a Global Variable: `https://host:port/myQueue-${C.vars.myVar}`.
 	QueueName string `json:"queueName"`
@@ -16412,21 +15339,9 @@ type InputS3 struct {
 // InputS3AwsAuthenticationMethod AWS authentication method. Choose Auto to use IAM roles.
 type InputS3AwsAuthenticationMethod string
 
-// InputS3CheckpointingEnabled Enable checkpointing to resume processing files after an interruption.
-type InputS3CheckpointingEnabled bool
-
-// InputS3PqCompress Codec to use to compress the persisted data.
-type InputS3PqCompress string
-
-// InputS3PqMode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-type InputS3PqMode string
-
 // InputS3PqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 type InputS3PqEnabled bool
 
-// InputS3PreprocessDisabled Enable Custom Command
-type InputS3PreprocessDisabled bool
-
 // InputS3SendToRoutes Select whether to send data to Routes, or directly to Destinations.
 type InputS3SendToRoutes bool
 
@@ -17129,28 +16044,7 @@ type InputSplunkHec struct {
 	AllowedIndexes *[]string `json:"allowedIndexes,omitempty"`
 
 	// AuthTokens Shared secrets to be provided by any client (Authorization: <token>). If empty, unauthorized access is permitted.
-	AuthTokens *[]struct {
-		// AllowedIndexesAtToken Enter the values you want to allow in the HEC event index field at the token level. Supports wildcards. To skip validation, leave blank.
-		AllowedIndexesAtToken *[]string `json:"allowedIndexesAtToken,omitempty"`
-
-		// AuthType Enter a token directly, or provide a secret referencing a token
-		AuthType *InputSplunkHecAuthTokensAuthType `json:"authType,omitempty"`
-
-		// Description Optional token description
-		Description *string `json:"description,omitempty"`
-		Enabled     *bool   `json:"enabled,omitempty"`
-
-		// Metadata Fields to add to events referencing this token
-		Metadata *[]struct {
-			// Name Field name
-			Name string `json:"name"`
-
-			// Value JavaScript expression to compute field's value, enclosed in quotes or backticks. (Can evaluate to a constant.)
-			Value string `json:"value"`
-		} `json:"metadata,omitempty"`
-		Token       interface{}  `json:"token"`
-		TokenSecret *interface{} `json:"tokenSecret,omitempty"`
-	} `json:"authTokens,omitempty"`
+	AuthTokens *[]InputSplunkHecAuthToken `json:"authTokens,omitempty"`
 
 	// BreakerRulesets A list of event-breaking rulesets that will be applied, in order, to the input data stream
 	BreakerRulesets *[]string `json:"breakerRulesets,omitempty"`
@@ -17159,15 +16053,9 @@ type InputSplunkHec struct {
 	CaptureHeaders *bool `json:"captureHeaders,omitempty"`
 
 	// Connections Direct connections to Destinations, optionally via a Pipeline or a Pack.
-	Connections *[]struct {
-		// Output Select a Destination.
-		Output string `json:"output"`
-
-		// Pipeline Select Pipeline or Pack. Optional.
-		Pipeline *string `json:"pipeline,omitempty"`
-	} `json:"connections,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Disabled    *bool   `json:"disabled,omitempty"`
+	Connections *[]InputConnection `json:"connections,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Disabled    *bool              `json:"disabled,omitempty"`
 
 	// DropControlFields Whether to drop Splunk control fields such as `crcSalt` and `_savedPort`. If false, control fields are stored in the internal field `__ctrlFields`.
 	DropControlFields *bool `json:"dropControlFields,omitempty"`
@@ -17207,41 +16095,14 @@ type InputSplunkHec struct {
 	MaxRequestsPerSocket *int `json:"maxRequestsPerSocket,omitempty"`
 
 	// Metadata Fields to add to every event. Overrides fields added at the token or request level. See [the Source documentation](https://docs.cribl.io/stream/sources-splunk-hec/#fields) for more info.
-	Metadata *[]struct {
-		// Name Field name
-		Name string `json:"name"`
-
-		// Value JavaScript expression to compute field's value, enclosed in quotes or backticks. (Can evaluate to a constant.)
-		Value string `json:"value"`
-	} `json:"metadata,omitempty"`
+	Metadata *[]InputMetadata `json:"metadata,omitempty"`
 
 	// Pipeline Pipeline to process data from this Source before sending it through the Routes
 	Pipeline *string `json:"pipeline,omitempty"`
 
 	// Port Port to listen on.
-	Port float32 `json:"port"`
-	Pq   *struct {
-		// CommitFrequency The number of events to send downstream before committing that Stream has read them.
-		CommitFrequency *float32 `json:"commitFrequency,omitempty"`
-
-		// Compress Codec to use to compress the persisted data.
-		Compress *InputSplunkHecPqCompress `json:"compress,omitempty"`
-
-		// MaxBufferSize The maximum number of events to hold in memory before writing the events to disk.
-		MaxBufferSize *float32 `json:"maxBufferSize,omitempty"`
-
-		// MaxFileSize The maximum size to store in each queue file before closing and optionally compressing. Enter a numeral with units of KB, MB, etc.
-		MaxFileSize *string `json:"maxFileSize,omitempty"`
-
-		// MaxSize The maximum disk space that the queue can consume (as an average per Worker Process) before queueing stops. Enter a numeral with units of KB, MB, etc.
-		MaxSize *string `json:"maxSize,omitempty"`
-
-		// Mode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-		Mode *InputSplunkHecPqMode `json:"mode,omitempty"`
-
-		// Path The location for the persistent queue files. To this field's value, the system will append: /<worker-id>/inputs/<input-id>.
-		Path *string `json:"path,omitempty"`
-	} `json:"pq,omitempty"`
+	Port float32  `json:"port"`
+	Pq   *InputPq `json:"pq,omitempty"`
 
 	// PqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 	PqEnabled *InputSplunkHecPqEnabled `json:"pqEnabled,omitempty"`
@@ -17265,65 +16126,20 @@ type InputSplunkHec struct {
 	StaleChannelFlushMs *float32 `json:"staleChannelFlushMs,omitempty"`
 
 	// Streamtags Tags for filtering and grouping in @{product}
-	Streamtags *[]string `json:"streamtags,omitempty"`
-	Tls        *struct {
-		// CaPath Path on server containing CA certificates to use. PEM format. Can reference $ENV_VARS.
-		CaPath *string `json:"caPath,omitempty"`
-
-		// CertPath Path on server containing certificates to use. PEM format. Can reference $ENV_VARS.
-		CertPath *string `json:"certPath,omitempty"`
-
-		// CertificateName The name of the predefined certificate.
-		CertificateName *string                    `json:"certificateName,omitempty"`
-		CommonNameRegex *interface{}               `json:"commonNameRegex,omitempty"`
-		Disabled        *InputSplunkHecTlsDisabled `json:"disabled,omitempty"`
-
-		// MaxVersion Maximum TLS version to accept from connections
-		MaxVersion *InputSplunkHecTlsMaxVersion `json:"maxVersion,omitempty"`
-
-		// MinVersion Minimum TLS version to accept from connections
-		MinVersion *InputSplunkHecTlsMinVersion `json:"minVersion,omitempty"`
-
-		// Passphrase Passphrase to use to decrypt private key.
-		Passphrase *string `json:"passphrase,omitempty"`
-
-		// PrivKeyPath Path on server containing the private key to use. PEM format. Can reference $ENV_VARS.
-		PrivKeyPath        *string      `json:"privKeyPath,omitempty"`
-		RejectUnauthorized *interface{} `json:"rejectUnauthorized,omitempty"`
-
-		// RequestCert Whether to require clients to present their certificates. Used to perform client authentication using SSL certs.
-		RequestCert *bool `json:"requestCert,omitempty"`
-	} `json:"tls,omitempty"`
-	Type *InputSplunkHecType `json:"type,omitempty"`
+	Streamtags *[]string           `json:"streamtags,omitempty"`
+	Tls        *InputTlsServerSide `json:"tls,omitempty"`
+	Type       *InputSplunkHecType `json:"type,omitempty"`
 
 	// UseFwdTimezone Enables Event Breakers to determine events' time zone from UF-provided metadata, when TZ can't be inferred from the raw event. Toggle to 'No' to disable this fallback.
 	UseFwdTimezone *bool `json:"useFwdTimezone,omitempty"`
 }
 
-// InputSplunkHecAuthTokensAuthType Enter a token directly, or provide a secret referencing a token
-type InputSplunkHecAuthTokensAuthType string
-
-// InputSplunkHecPqCompress Codec to use to compress the persisted data.
-type InputSplunkHecPqCompress string
-
-// InputSplunkHecPqMode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-type InputSplunkHecPqMode string
-
 // InputSplunkHecPqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 type InputSplunkHecPqEnabled bool
 
 // InputSplunkHecSendToRoutes Select whether to send data to Routes, or directly to Destinations.
 type InputSplunkHecSendToRoutes bool
 
-// InputSplunkHecTlsDisabled defines model for InputSplunkHec.Tls.Disabled.
-type InputSplunkHecTlsDisabled bool
-
-// InputSplunkHecTlsMaxVersion Maximum TLS version to accept from connections
-type InputSplunkHecTlsMaxVersion string
-
-// InputSplunkHecTlsMinVersion Minimum TLS version to accept from connections
-type InputSplunkHecTlsMinVersion string
-
 // InputSplunkHecType defines model for InputSplunkHec.Type.
 type InputSplunkHecType string
 
@@ -17594,13 +16410,7 @@ type InputSqs struct {
 	AwsSecretKey *string `json:"awsSecretKey,omitempty"`
 
 	// Connections Direct connections to Destinations, optionally via a Pipeline or a Pack.
-	Connections *[]struct {
-		// Output Select a Destination.
-		Output string `json:"output"`
-
-		// Pipeline Select Pipeline or Pack. Optional.
-		Pipeline *string `json:"pipeline,omitempty"`
-	} `json:"connections,omitempty"`
+	Connections *[]InputConnection `json:"connections,omitempty"`
 
 	// CreateQueue Create queue if it does not exist.
 	CreateQueue *bool   `json:"createQueue,omitempty"`
@@ -17626,13 +16436,7 @@ type InputSqs struct {
 	MaxMessages *float32 `json:"maxMessages,omitempty"`
 
 	// Metadata Fields to add to events from this input
-	Metadata *[]struct {
-		// Name Field name
-		Name string `json:"name"`
-
-		// Value JavaScript expression to compute field's value, enclosed in quotes or backticks. (Can evaluate to a constant.)
-		Value string `json:"value"`
-	} `json:"metadata,omitempty"`
+	Metadata *[]InputMetadata `json:"metadata,omitempty"`
 
 	// NumReceivers The Number of receiver processes to run, the higher the number the better throughput at the expense of CPU overhead
 	NumReceivers *float32 `json:"numReceivers,omitempty"`
@@ -17642,28 +16446,7 @@ type InputSqs struct {
 
 	// PollTimeout The amount of time to wait for events before trying polling again. The lower the number the higher the AWS bill. The higher the number the longer it will take for the source to react to configuration changes and system restarts.
 	PollTimeout *float32 `json:"pollTimeout,omitempty"`
-	Pq          *struct {
-		// CommitFrequency The number of events to send downstream before committing that Stream has read them.
-		CommitFrequency *float32 `json:"commitFrequency,omitempty"`
-
-		// Compress Codec to use to compress the persisted data.
-		Compress *InputSqsPqCompress `json:"compress,omitempty"`
-
-		// MaxBufferSize The maximum number of events to hold in memory before writing the events to disk.
-		MaxBufferSize *float32 `json:"maxBufferSize,omitempty"`
-
-		// MaxFileSize The maximum size to store in each queue file before closing and optionally compressing. Enter a numeral with units of KB, MB, etc.
-		MaxFileSize *string `json:"maxFileSize,omitempty"`
-
-		// MaxSize The maximum disk space that the queue can consume (as an average per Worker Process) before queueing stops. Enter a numeral with units of KB, MB, etc.
-		MaxSize *string `json:"maxSize,omitempty"`
-
-		// Mode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-		Mode *InputSqsPqMode `json:"mode,omitempty"`
-
-		// Path The location for the persistent queue files. To this field's value, the system will append: /<worker-id>/inputs/<input-id>.
-		Path *string `json:"path,omitempty"`
-	} `json:"pq,omitempty"`
+	Pq          *InputPq `json:"pq,omitempty"`
 
 	// PqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 	PqEnabled *InputSqsPqEnabled `json:"pqEnabled,omitempty"`
@@ -17700,12 +16483,6 @@ type InputSqs struct {
 // InputSqsAwsAuthenticationMethod AWS authentication method. Choose Auto to use IAM roles.
 type InputSqsAwsAuthenticationMethod string
 
-// InputSqsPqCompress Codec to use to compress the persisted data.
-type InputSqsPqCompress string
-
-// InputSqsPqMode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-type InputSqsPqMode string
-
 // InputSqsPqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 type InputSqsPqEnabled bool
 
@@ -17740,15 +16517,9 @@ type InputSyslog struct {
 	AllowNonStandardAppName *bool `json:"allowNonStandardAppName,omitempty"`
 
 	// Connections Direct connections to Destinations, optionally via a Pipeline or a Pack.
-	Connections *[]struct {
-		// Output Select a Destination.
-		Output string `json:"output"`
-
-		// Pipeline Select Pipeline or Pack. Optional.
-		Pipeline *string `json:"pipeline,omitempty"`
-	} `json:"connections,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Disabled    *bool   `json:"disabled,omitempty"`
+	Connections *[]InputConnection `json:"connections,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Disabled    *bool              `json:"disabled,omitempty"`
 
 	// EnableLoadBalancing Load balance traffic across all Worker Processes
 	EnableLoadBalancing *bool `json:"enableLoadBalancing,omitempty"`
@@ -17781,41 +16552,14 @@ type InputSyslog struct {
 	MaxBufferSize *float32 `json:"maxBufferSize,omitempty"`
 
 	// Metadata Fields to add to events from this input
-	Metadata *[]struct {
-		// Name Field name
-		Name string `json:"name"`
-
-		// Value JavaScript expression to compute field's value, enclosed in quotes or backticks. (Can evaluate to a constant.)
-		Value string `json:"value"`
-	} `json:"metadata,omitempty"`
+	Metadata *[]InputMetadata `json:"metadata,omitempty"`
 
 	// OctetCounting Enable if incoming messages use octet counting per RFC 6587.
 	OctetCounting *bool `json:"octetCounting,omitempty"`
 
 	// Pipeline Pipeline to process data from this Source before sending it through the Routes
-	Pipeline *string `json:"pipeline,omitempty"`
-	Pq       *struct {
-		// CommitFrequency The number of events to send downstream before committing that Stream has read them.
-		CommitFrequency *float32 `json:"commitFrequency,omitempty"`
-
-		// Compress Codec to use to compress the persisted data.
-		Compress *InputSyslogPqCompress `json:"compress,omitempty"`
-
-		// MaxBufferSize The maximum number of events to hold in memory before writing the events to disk.
-		MaxBufferSize *float32 `json:"maxBufferSize,omitempty"`
-
-		// MaxFileSize The maximum size to store in each queue file before closing and optionally compressing. Enter a numeral with units of KB, MB, etc.
-		MaxFileSize *string `json:"maxFileSize,omitempty"`
-
-		// MaxSize The maximum disk space that the queue can consume (as an average per Worker Process) before queueing stops. Enter a numeral with units of KB, MB, etc.
-		MaxSize *string `json:"maxSize,omitempty"`
-
-		// Mode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-		Mode *InputSyslogPqMode `json:"mode,omitempty"`
-
-		// Path The location for the persistent queue files. To this field's value, the system will append: /<worker-id>/inputs/<input-id>.
-		Path *string `json:"path,omitempty"`
-	} `json:"pq,omitempty"`
+	Pipeline *string  `json:"pipeline,omitempty"`
+	Pq       *InputPq `json:"pq,omitempty"`
 
 	// PqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 	PqEnabled *InputSyslogPqEnabled `json:"pqEnabled,omitempty"`
@@ -17845,36 +16589,9 @@ type InputSyslog struct {
 	TcpPort *float32 `json:"tcpPort,omitempty"`
 
 	// TimestampTimezone Timezone to assign to timestamps without timezone info
-	TimestampTimezone *string `json:"timestampTimezone,omitempty"`
-	Tls               *struct {
-		// CaPath Path on server containing CA certificates to use. PEM format. Can reference $ENV_VARS.
-		CaPath *string `json:"caPath,omitempty"`
-
-		// CertPath Path on server containing certificates to use. PEM format. Can reference $ENV_VARS.
-		CertPath *string `json:"certPath,omitempty"`
-
-		// CertificateName The name of the predefined certificate.
-		CertificateName *string                 `json:"certificateName,omitempty"`
-		CommonNameRegex *interface{}            `json:"commonNameRegex,omitempty"`
-		Disabled        *InputSyslogTlsDisabled `json:"disabled,omitempty"`
-
-		// MaxVersion Maximum TLS version to accept from connections
-		MaxVersion *InputSyslogTlsMaxVersion `json:"maxVersion,omitempty"`
-
-		// MinVersion Minimum TLS version to accept from connections
-		MinVersion *InputSyslogTlsMinVersion `json:"minVersion,omitempty"`
-
-		// Passphrase Passphrase to use to decrypt private key.
-		Passphrase *string `json:"passphrase,omitempty"`
-
-		// PrivKeyPath Path on server containing the private key to use. PEM format. Can reference $ENV_VARS.
-		PrivKeyPath        *string      `json:"privKeyPath,omitempty"`
-		RejectUnauthorized *interface{} `json:"rejectUnauthorized,omitempty"`
-
-		// RequestCert Whether to require clients to present their certificates. Used to perform client authentication using SSL certs.
-		RequestCert *bool `json:"requestCert,omitempty"`
-	} `json:"tls,omitempty"`
-	Type InputSyslogType `json:"type"`
+	TimestampTimezone *string             `json:"timestampTimezone,omitempty"`
+	Tls               *InputTlsServerSide `json:"tls,omitempty"`
+	Type              InputSyslogType     `json:"type"`
 
 	// UdpPort Enter UDP port number to listen on. Not required if listening on TCP.
 	UdpPort *float32 `json:"udpPort,omitempty"`
@@ -17884,27 +16601,12 @@ type InputSyslog struct {
 	Union              json.RawMessage
 }
 
-// InputSyslogPqCompress Codec to use to compress the persisted data.
-type InputSyslogPqCompress string
-
-// InputSyslogPqMode With Smart mode, PQ will write events to the filesystem only when it detects backpressure from the processing engine. With Always On mode, PQ will always write events directly to the queue before forwarding them to the processing engine.
-type InputSyslogPqMode string
-
 // InputSyslogPqEnabled Use a disk queue to minimize data loss when connected services block. See [Cribl's docs](https://docs.cribl.io/stream/persistent-queues) for PQ defaults (Cribl-managed Cloud Workers) and configuration options (on-prem and hybrid Workers).
 type InputSyslogPqEnabled bool
 
 // InputSyslogSendToRoutes Select whether to send data to Routes, or directly to Destinations.
 type InputSyslogSendToRoutes bool
 
-// InputSyslogTlsDisabled defines model for InputSyslog.Tls.Disabled.
-type InputSyslogTlsDisabled bool
-
-// InputSyslogTlsMaxVersion Maximum TLS version to accept from connections
-type InputSyslogTlsMaxVersion string
-
-// InputSyslogTlsMinVersion Minimum TLS version to accept from connections
-type InputSyslogTlsMinVersion string
-
 // InputSyslogType defines model for InputSyslog.Type.
 type InputSyslogType string
 
@@ -19778,6 +18480,9 @@ type LookupFile struct {
 	Description *string `json:"description,omitempty"`
 	Id          string  `json:"id"`
 
+	// Mode Whether the lookup is loaded into memory or kept on disk. Optional.
+	Mode *string `json:"mode,omitempty"`
+
 	// Size File size. Optional.
 	Size *float32 `json:"size,omitempty"`
 
@@ -23212,11 +21917,7 @@ type OutputDynatraceOtlpType string
 
 // OutputElastic defines model for OutputElastic.
 type OutputElastic struct {
-	Auth *struct {
-		// AuthType Enter credentials directly, or select a stored secret
-		AuthType *OutputElasticAuthAuthType `json:"authType,omitempty"`
-		Disabled OutputElasticAuthDisabled  `json:"disabled"`
-	} `json:"auth,omitempty"`
+	Auth *OutputElasticAuth `json:"auth,omitempty"`
 
 	// Compress Compress the payload body before sending
 	Compress *bool `json:"compress,omitempty"`
@@ -23244,22 +21945,10 @@ type OutputElastic struct {
 	ExcludeSelf *bool `json:"excludeSelf,omitempty"`
 
 	// ExtraHttpHeaders Headers to add to all events.
-	ExtraHttpHeaders *[]struct {
-		// Name Field name
-		Name *string `json:"name,omitempty"`
-
-		// Value Field value
-		Value string `json:"value"`
-	} `json:"extraHttpHeaders,omitempty"`
+	ExtraHttpHeaders *[]OutputExtraHttpHeader `json:"extraHttpHeaders,omitempty"`
 
 	// ExtraParams Extra Parameters.
-	ExtraParams *[]struct {
-		// Name Field name
-		Name string `json:"name"`
-
-		// Value Field value
-		Value string `json:"value"`
-	} `json:"extraParams,omitempty"`
+	ExtraParams *[]OutputExtraParam `json:"extraParams,omitempty"`
 
 	// FailedRequestLoggingMode Data to log when a request fails. All headers are redacted by default, unless listed as safe headers below.
 	FailedRequestLoggingMode *OutputElasticFailedRequestLoggingMode `json:"failedRequestLoggingMode,omitempty"`
@@ -23322,19 +22011,7 @@ type OutputElastic struct {
 	ResponseHonorRetryAfterHeader *bool `json:"responseHonorRetryAfterHeader,omitempty"`
 
 	// ResponseRetrySettings Automatically retry after unsuccessful response status codes, such as 429 (Too Many Requests) or 503 (Service Unavailable).
-	ResponseRetrySettings *[]struct {
-		// BackoffRate Base for exponential backoff. A value of 2 (default) means Cribl Stream will retry after 2 seconds, then 4 seconds, then 8 seconds, etc.
-		BackoffRate *float32 `json:"backoffRate,omitempty"`
-
-		// HttpStatus The HTTP response status code that will trigger retries
-		HttpStatus float32 `json:"httpStatus"`
-
-		// InitialBackoff How long, in milliseconds, Cribl Stream should wait before initiating backoff. Maximum interval is 600,000 ms (10 minutes).
-		InitialBackoff *float32 `json:"initialBackoff,omitempty"`
-
-		// MaxBackoff The maximum backoff interval, in milliseconds, Cribl Stream should apply. Default (and minimum) is 10,000 ms (10 seconds); maximum is 180,000 ms (180 seconds).
-		MaxBackoff *float32 `json:"maxBackoff,omitempty"`
-	} `json:"responseRetrySettings,omitempty"`
+	ResponseRetrySettings *[]OutputResponseRetrySetting `json:"responseRetrySettings,omitempty"`
 
 	// RetryPartialErrors Retry failed events when a bulk request to Elastic is successful, but the response body returns an error for one or more events in the batch
 	RetryPartialErrors *bool `json:"retryPartialErrors,omitempty"`
@@ -23346,34 +22023,16 @@ type OutputElastic struct {
 	Streamtags *[]string `json:"streamtags,omitempty"`
 
 	// SystemFields Fields to automatically add to events, such as cribl_pipe. Supports wildcards.
-	SystemFields         *[]string `json:"systemFields,omitempty"`
-	TimeoutRetrySettings *struct {
-		// BackoffRate Base for exponential backoff. A value of 2 (default) means Cribl Stream will retry after 2 seconds, then 4 seconds, then 8 seconds, etc.
-		BackoffRate *float32 `json:"backoffRate,omitempty"`
-
-		// InitialBackoff How long, in milliseconds, Cribl Stream should wait before initiating backoff. Maximum interval is 600,000 ms (10 minutes).
-		InitialBackoff *float32 `json:"initialBackoff,omitempty"`
-
-		// MaxBackoff The maximum backoff interval, in milliseconds, Cribl Stream should apply. Default (and minimum) is 10,000 ms (10 seconds); maximum is 180,000 ms (180 seconds).
-		MaxBackoff *float32 `json:"maxBackoff,omitempty"`
-
-		// TimeoutRetry Enable to retry on request timeout
-		TimeoutRetry OutputElasticTimeoutRetrySettingsTimeoutRetry `json:"timeoutRetry"`
-	} `json:"timeoutRetrySettings,omitempty"`
+	SystemFields         *[]string                   `json:"systemFields,omitempty"`
+	TimeoutRetrySettings *OutputTimeoutRetrySettings `json:"timeoutRetrySettings,omitempty"`
 
 	// TimeoutSec Amount of time, in seconds, to wait for a request to complete before canceling it
 	TimeoutSec *float32          `json:"timeoutSec,omitempty"`
 	Type       OutputElasticType `json:"type"`
 
 	// Url The Cloud ID or URL to an Elastic cluster to send events to. Example: http://elastic:9200/_bulk
-	Url  *string `json:"url,omitempty"`
-	Urls *[]struct {
-		// Url The URL to an Elastic node to send events to. Example: http://elastic:9200/_bulk
-		Url string `json:"url"`
-
-		// Weight Assign a weight (>0) to each endpoint to indicate its traffic-handling capability
-		Weight *float32 `json:"weight,omitempty"`
-	} `json:"urls,omitempty"`
+	Url  *string                  `json:"url,omitempty"`
+	Urls *[]OutputLoadBalancedUrl `json:"urls,omitempty"`
 
 	// UseRoundRobinDns Enables round-robin DNS lookup. When a DNS server returns multiple addresses, @{product} will cycle through them in the order returned. For optimal performance, consider enabling this setting for non-load balanced destinations.
 	UseRoundRobinDns *bool `json:"useRoundRobinDns,omitempty"`
@@ -23382,12 +22041,6 @@ type OutputElastic struct {
 	WriteAction *OutputElasticWriteAction `json:"writeAction,omitempty"`
 }
 
-// OutputElasticAuthAuthType Enter credentials directly, or select a stored secret
-type OutputElasticAuthAuthType string
-
-// OutputElasticAuthDisabled defines model for OutputElastic.Auth.Disabled.
-type OutputElasticAuthDisabled bool
-
 // OutputElasticElasticVersion Optional Elasticsearch version, used to format events. If not specified, will auto-discover version.
 type OutputElasticElasticVersion string
 
@@ -23409,9 +22062,6 @@ type OutputElasticPqMode string
 // OutputElasticPqOnBackpressure Whether to block or drop events when the queue is exerting backpressure (full capacity or low disk). 'Block' is the same behavior as non-PQ blocking. 'Drop new data' throws away incoming data, while leaving the contents of the PQ unchanged.
 type OutputElasticPqOnBackpressure string
 
-// OutputElasticTimeoutRetrySettingsTimeoutRetry Enable to retry on request timeout
-type OutputElasticTimeoutRetrySettingsTimeoutRetry bool
-
 // OutputElasticType defines model for OutputElastic.Type.
 type OutputElasticType string
 
@@ -25357,68 +24007,8 @@ type OutputKafka struct {
 	Id *string `json:"id,omitempty"`
 
 	// InitialBackoff Initial value used to calculate the retry, in milliseconds. Maximum is 600,000 ms (10 minutes).
-	InitialBackoff      *float32 `json:"initialBackoff,omitempty"`
-	KafkaSchemaRegistry *struct {
-		// Auth Credentials to use when authenticating with the schema registry using basic HTTP authentication
-		Auth *struct {
-			// CredentialsSecret Select or create a secret that references your credentials
-			CredentialsSecret *string `json:"credentialsSecret,omitempty"`
-
-			// Disabled Enable authentication
-			Disabled OutputKafkaKafkaSchemaRegistryAuthDisabled `json:"disabled"`
-		} `json:"auth,omitempty"`
-
-		// ConnectionTimeout Maximum time to wait for a Schema Registry connection to complete successfully
-		ConnectionTimeout *float32 `json:"connectionTimeout,omitempty"`
-
-		// DefaultKeySchemaId Used when __keySchemaIdOut is not present, to transform key values, leave blank if key transformation is not required by default.
-		DefaultKeySchemaId *float32 `json:"defaultKeySchemaId,omitempty"`
-
-		// DefaultValueSchemaId Used when __valueSchemaIdOut is not present, to transform _raw, leave blank if value transformation is not required by default.
-		DefaultValueSchemaId *float32 `json:"defaultValueSchemaId,omitempty"`
-
-		// Disabled Enable Schema Registry
-		Disabled OutputKafkaKafkaSchemaRegistryDisabled `json:"disabled"`
-
-		// MaxRetries Maximum number of times to try fetching schemas from the Schema Registry
-		MaxRetries *float32 `json:"maxRetries,omitempty"`
-
-		// RequestTimeout Maximum time to wait for the Schema Registry to respond to a request
-		RequestTimeout *float32 `json:"requestTimeout,omitempty"`
-
-		// SchemaRegistryURL URL for accessing the Confluent Schema Registry. Example: http://localhost:8081. To connect over TLS, use https instead of http.
-		SchemaRegistryURL *string `json:"schemaRegistryURL,omitempty"`
-		Tls               *struct {
-			// CaPath Path on client in which to find CA certificates to verify the server's cert. PEM format. Can reference $ENV_VARS.
-			CaPath *string `json:"caPath,omitempty"`
-
-			// CertPath Path on client in which to find certificates to use. PEM format. Can reference $ENV_VARS.
-			CertPath *string `json:"certPath,omitempty"`
-
-			// CertificateName The name of the predefined certificate.
-			CertificateName *string                                    `json:"certificateName,omitempty"`
-			Disabled        *OutputKafkaKafkaSchemaRegistryTlsDisabled `json:"disabled,omitempty"`
-
-			// MaxVersion Maximum TLS version to use when connecting
-			MaxVersion *OutputKafkaKafkaSchemaRegistryTlsMaxVersion `json:"maxVersion,omitempty"`
-
-			// MinVersion Minimum TLS version to use when connecting
-			MinVersion *OutputKafkaKafkaSchemaRegistryTlsMinVersion `json:"minVersion,omitempty"`
-
-			// Passphrase Passphrase to use to decrypt private key.
-			Passphrase *string `json:"passphrase,omitempty"`
-
-			// PrivKeyPath Path on client in which to find the private key to use. PEM format. Can reference $ENV_VARS.
-			PrivKeyPath *string `json:"privKeyPath,omitempty"`
-
-			// RejectUnauthorized Reject certs that are not authorized by a CA in the CA certificate path, or by another
-			//                     trusted CA (e.g., the system's CA). Defaults to Yes. Overrides the toggle from Advanced Settings, when also present.
-			RejectUnauthorized *bool `json:"rejectUnauthorized,omitempty"`
-
-			// Servername Server name for the SNI (Server Name Indication) TLS extension. It must be a host name, and not an IP address.
-			Servername *string `json:"servername,omitempty"`
-		} `json:"tls,omitempty"`
-	} `json:"kafkaSchemaRegistry,omitempty"`
+	InitialBackoff      *float32             `json:"initialBackoff,omitempty"`
+	KafkaSchemaRegistry *KafkaSchemaRegistry `json:"kafkaSchemaRegistry,omitempty"`
 
 	// MaxBackOff The maximum wait time for a retry, in milliseconds. Default (and minimum) is 30,000 ms (30 seconds); maximum is 180,000 ms (180 seconds).
 	MaxBackOff *float32 `json:"maxBackOff,omitempty"`
@@ -25464,49 +24054,14 @@ type OutputKafka struct {
 	RequestTimeout *float32 `json:"requestTimeout,omitempty"`
 
 	// Sasl Authentication parameters to use when connecting to brokers. Using TLS is highly recommended.
-	Sasl *struct {
-		// Disabled Enable Authentication
-		Disabled OutputKafkaSaslDisabled `json:"disabled"`
-
-		// Mechanism SASL authentication mechanism to use.
-		Mechanism *OutputKafkaSaslMechanism `json:"mechanism,omitempty"`
-	} `json:"sasl,omitempty"`
+	Sasl *KafkaSasl `json:"sasl,omitempty"`
 
 	// Streamtags Tags for filtering and grouping in @{product}
 	Streamtags *[]string `json:"streamtags,omitempty"`
 
 	// SystemFields Fields to automatically add to events, such as cribl_pipe. Supports wildcards.
-	SystemFields *[]string `json:"systemFields,omitempty"`
-	Tls          *struct {
-		// CaPath Path on client in which to find CA certificates to verify the server's cert. PEM format. Can reference $ENV_VARS.
-		CaPath *string `json:"caPath,omitempty"`
-
-		// CertPath Path on client in which to find certificates to use. PEM format. Can reference $ENV_VARS.
-		CertPath *string `json:"certPath,omitempty"`
-
-		// CertificateName The name of the predefined certificate.
-		CertificateName *string                 `json:"certificateName,omitempty"`
-		Disabled        *OutputKafkaTlsDisabled `json:"disabled,omitempty"`
-
-		// MaxVersion Maximum TLS version to use when connecting
-		MaxVersion *OutputKafkaTlsMaxVersion `json:"maxVersion,omitempty"`
-
-		// MinVersion Minimum TLS version to use when connecting
-		MinVersion *OutputKafkaTlsMinVersion `json:"minVersion,omitempty"`
-
-		// Passphrase Passphrase to use to decrypt private key.
-		Passphrase *string `json:"passphrase,omitempty"`
-
-		// PrivKeyPath Path on client in which to find the private key to use. PEM format. Can reference $ENV_VARS.
-		PrivKeyPath *string `json:"privKeyPath,omitempty"`
-
-		// RejectUnauthorized Reject certs that are not authorized by a CA in the CA certificate path, or by another
-		//                     trusted CA (e.g., the system's CA). Defaults to Yes. Overrides the toggle from Advanced Settings, when also present.
-		RejectUnauthorized *bool `json:"rejectUnauthorized,omitempty"`
-
-		// Servername Server name for the SNI (Server Name Indication) TLS extension. It must be a host name, and not an IP address.
-		Servername *string `json:"servername,omitempty"`
-	} `json:"tls,omitempty"`
+	SystemFields *[]string            `json:"systemFields,omitempty"`
+	Tls          *OutputTlsClientSide `json:"tls,omitempty"`
 
 	// Topic The topic to publish events to. Can be overridden using the __topicOut field.
 	Topic string           `json:"topic"`
@@ -25522,21 +24077,6 @@ type OutputKafkaCompression string
 // OutputKafkaFormat Format to use to serialize events before writing to Kafka.
 type OutputKafkaFormat string
 
-// OutputKafkaKafkaSchemaRegistryAuthDisabled Enable authentication
-type OutputKafkaKafkaSchemaRegistryAuthDisabled bool
-
-// OutputKafkaKafkaSchemaRegistryDisabled Enable Schema Registry
-type OutputKafkaKafkaSchemaRegistryDisabled bool
-
-// OutputKafkaKafkaSchemaRegistryTlsDisabled defines model for OutputKafka.KafkaSchemaRegistry.Tls.Disabled.
-type OutputKafkaKafkaSchemaRegistryTlsDisabled bool
-
-// OutputKafkaKafkaSchemaRegistryTlsMaxVersion Maximum TLS version to use when connecting
-type OutputKafkaKafkaSchemaRegistryTlsMaxVersion string
-
-// OutputKafkaKafkaSchemaRegistryTlsMinVersion Minimum TLS version to use when connecting
-type OutputKafkaKafkaSchemaRegistryTlsMinVersion string
-
 // OutputKafkaOnBackpressure Whether to block, drop, or queue events when all receivers are exerting backpressure.
 type OutputKafkaOnBackpressure string
 
@@ -25549,21 +24089,6 @@ type OutputKafkaPqMode string
 // OutputKafkaPqOnBackpressure Whether to block or drop events when the queue is exerting backpressure (full capacity or low disk). 'Block' is the same behavior as non-PQ blocking. 'Drop new data' throws away incoming data, while leaving the contents of the PQ unchanged.
 type OutputKafkaPqOnBackpressure string
 
-// OutputKafkaSaslDisabled Enable Authentication
-type OutputKafkaSaslDisabled bool
-
-// OutputKafkaSaslMechanism SASL authentication mechanism to use.
-type OutputKafkaSaslMechanism string
-
-// OutputKafkaTlsDisabled defines model for OutputKafka.Tls.Disabled.
-type OutputKafkaTlsDisabled bool
-
-// OutputKafkaTlsMaxVersion Maximum TLS version to use when connecting
-type OutputKafkaTlsMaxVersion string
-
-// OutputKafkaTlsMinVersion Minimum TLS version to use when connecting
-type OutputKafkaTlsMinVersion string
-
 // OutputKafkaType defines model for OutputKafka.Type.
 type OutputKafkaType string
 
@@ -26755,13 +25280,7 @@ type OutputOpenTelemetry struct {
 	Environment *string `json:"environment,omitempty"`
 
 	// ExtraHttpHeaders Headers to add to all events.
-	ExtraHttpHeaders *[]struct {
-		// Name Field name
-		Name *string `json:"name,omitempty"`
-
-		// Value Field value
-		Value string `json:"value"`
-	} `json:"extraHttpHeaders,omitempty"`
+	ExtraHttpHeaders *[]OutputExtraHttpHeader `json:"extraHttpHeaders,omitempty"`
 
 	// FailedRequestLoggingMode Data to log when a request fails. All headers are redacted by default, unless listed as safe headers below.
 	FailedRequestLoggingMode *OutputOpenTelemetryFailedRequestLoggingMode `json:"failedRequestLoggingMode,omitempty"`
@@ -26797,28 +25316,13 @@ type OutputOpenTelemetry struct {
 	MaxPayloadSizeKB *float32 `json:"maxPayloadSizeKB,omitempty"`
 
 	// Metadata List of key-value pairs to send with each gRPC request. Value supports JavaScript expressions that are evaluated just once, when the destination gets started. To pass credentials as metadata, use 'C.Secret'.
-	Metadata *[]struct {
-		Key   string `json:"key"`
-		Value string `json:"value"`
-	} `json:"metadata,omitempty"`
+	Metadata *[]OutputOpenTelemetryMetadata `json:"metadata,omitempty"`
 
 	// OauthHeaders Additional headers to send in the OAuth login request. @{product} will automatically add the content-type header 'application/x-www-form-urlencoded' when sending this request.
-	OauthHeaders *[]struct {
-		// Name OAuth header name
-		Name string `json:"name"`
-
-		// Value OAuth header value
-		Value string `json:"value"`
-	} `json:"oauthHeaders,omitempty"`
+	OauthHeaders *[]OauthHeader `json:"oauthHeaders,omitempty"`
 
 	// OauthParams Additional parameters to send in the OAuth login request. @{product} will combine the secret with these parameters, and will send the URL-encoded result in a POST request to the endpoint specified in the 'Login URL'. We'll automatically add the content-type header 'application/x-www-form-urlencoded' when sending this request.
-	OauthParams *[]struct {
-		// Name OAuth parameter name
-		Name string `json:"name"`
-
-		// Value OAuth parameter value
-		Value string `json:"value"`
-	} `json:"oauthParams,omitempty"`
+	OauthParams *[]OauthParam `json:"oauthParams,omitempty"`
 
 	// OnBackpressure Whether to block, drop, or queue events when all receivers are exerting backpressure.
 	OnBackpressure *OutputOpenTelemetryOnBackpressure `json:"onBackpressure,omitempty"`
@@ -26863,19 +25367,7 @@ type OutputOpenTelemetry struct {
 	ResponseHonorRetryAfterHeader *bool `json:"responseHonorRetryAfterHeader,omitempty"`
 
 	// ResponseRetrySettings Automatically retry after unsuccessful response status codes, such as 429 (Too Many Requests) or 503 (Service Unavailable).
-	ResponseRetrySettings *[]struct {
-		// BackoffRate Base for exponential backoff. A value of 2 (default) means Cribl Stream will retry after 2 seconds, then 4 seconds, then 8 seconds, etc.
-		BackoffRate *float32 `json:"backoffRate,omitempty"`
-
-		// HttpStatus The HTTP response status code that will trigger retries
-		HttpStatus float32 `json:"httpStatus"`
-
-		// InitialBackoff How long, in milliseconds, Cribl Stream should wait before initiating backoff. Maximum interval is 600,000 ms (10 minutes).
-		InitialBackoff *float32 `json:"initialBackoff,omitempty"`
-
-		// MaxBackoff The maximum backoff interval, in milliseconds, Cribl Stream should apply. Default (and minimum) is 10,000 ms (10 seconds); maximum is 180,000 ms (180 seconds).
-		MaxBackoff *float32 `json:"maxBackoff,omitempty"`
-	} `json:"responseRetrySettings,omitempty"`
+	ResponseRetrySettings *[]OutputResponseRetrySetting `json:"responseRetrySettings,omitempty"`
 
 	// SafeHeaders List of headers that are safe to log in plain text
 	SafeHeaders *[]string `json:"safeHeaders,omitempty"`
@@ -26894,50 +25386,12 @@ type OutputOpenTelemetry struct {
 	SystemFields *[]string `json:"systemFields,omitempty"`
 
 	// TextSecret Select or create a stored text secret
-	TextSecret           *string `json:"textSecret,omitempty"`
-	TimeoutRetrySettings *struct {
-		// BackoffRate Base for exponential backoff. A value of 2 (default) means Cribl Stream will retry after 2 seconds, then 4 seconds, then 8 seconds, etc.
-		BackoffRate *float32 `json:"backoffRate,omitempty"`
-
-		// InitialBackoff How long, in milliseconds, Cribl Stream should wait before initiating backoff. Maximum interval is 600,000 ms (10 minutes).
-		InitialBackoff *float32 `json:"initialBackoff,omitempty"`
-
-		// MaxBackoff The maximum backoff interval, in milliseconds, Cribl Stream should apply. Default (and minimum) is 10,000 ms (10 seconds); maximum is 180,000 ms (180 seconds).
-		MaxBackoff *float32 `json:"maxBackoff,omitempty"`
-
-		// TimeoutRetry Enable to retry on request timeout
-		TimeoutRetry OutputOpenTelemetryTimeoutRetrySettingsTimeoutRetry `json:"timeoutRetry"`
-	} `json:"timeoutRetrySettings,omitempty"`
+	TextSecret           *string                     `json:"textSecret,omitempty"`
+	TimeoutRetrySettings *OutputTimeoutRetrySettings `json:"timeoutRetrySettings,omitempty"`
 
 	// TimeoutSec Amount of time, in seconds, to wait for a request to complete before canceling it
-	TimeoutSec *float32 `json:"timeoutSec,omitempty"`
-	Tls        *struct {
-		// CaPath Path on client in which to find CA certificates to verify the server's cert. PEM format. Can reference $ENV_VARS.
-		CaPath *string `json:"caPath,omitempty"`
-
-		// CertPath Path on client in which to find certificates to use. PEM format. Can reference $ENV_VARS.
-		CertPath *string `json:"certPath,omitempty"`
-
-		// CertificateName The name of the predefined certificate.
-		CertificateName *string                         `json:"certificateName,omitempty"`
-		Disabled        *OutputOpenTelemetryTlsDisabled `json:"disabled,omitempty"`
-
-		// MaxVersion Maximum TLS version to use when connecting
-		MaxVersion *OutputOpenTelemetryTlsMaxVersion `json:"maxVersion,omitempty"`
-
-		// MinVersion Minimum TLS version to use when connecting
-		MinVersion *OutputOpenTelemetryTlsMinVersion `json:"minVersion,omitempty"`
-
-		// Passphrase Passphrase to use to decrypt private key.
-		Passphrase *string `json:"passphrase,omitempty"`
-
-		// PrivKeyPath Path on client in which to find the private key to use. PEM format. Can reference $ENV_VARS.
-		PrivKeyPath *string `json:"privKeyPath,omitempty"`
-
-		// RejectUnauthorized Reject certs that are not authorized by a CA in the CA certificate path, or by another
-		//                     trusted CA (e.g., the system's CA). Defaults to Yes. Overrides the toggle from Advanced Settings, when also present.
-		RejectUnauthorized *bool `json:"rejectUnauthorized,omitempty"`
-	} `json:"tls,omitempty"`
+	TimeoutSec *float32             `json:"timeoutSec,omitempty"`
+	Tls        *OutputTlsClientSide `json:"tls,omitempty"`
 
 	// Token Bearer token to include in the authorization header
 	Token *string `json:"token,omitempty"`
@@ -26986,18 +25440,6 @@ type OutputOpenTelemetryPqOnBackpressure string
 // OutputOpenTelemetryProtocol Select a transport option for OpenTelemetry
 type OutputOpenTelemetryProtocol string
 
-// OutputOpenTelemetryTimeoutRetrySettingsTimeoutRetry Enable to retry on request timeout
-type OutputOpenTelemetryTimeoutRetrySettingsTimeoutRetry bool
-
-// OutputOpenTelemetryTlsDisabled defines model for OutputOpenTelemetry.Tls.Disabled.
-type OutputOpenTelemetryTlsDisabled bool
-
-// OutputOpenTelemetryTlsMaxVersion Maximum TLS version to use when connecting
-type OutputOpenTelemetryTlsMaxVersion string
-
-// OutputOpenTelemetryTlsMinVersion Minimum TLS version to use when connecting
-type OutputOpenTelemetryTlsMinVersion string
-
 // OutputOpenTelemetryType defines model for OutputOpenTelemetry.Type.
 type OutputOpenTelemetryType string
 
@@ -27383,10 +25825,7 @@ type OutputS3 struct {
 	Id *string `json:"id,omitempty"`
 
 	// KeyValueMetadata The metadata of files the Destination writes will include the properties you add here as key-value pairs. Useful for tagging, e.g., "key":"OCSF Event Class", "value":"9001".
-	KeyValueMetadata *[]struct {
-		Key   string `json:"key"`
-		Value string `json:"value"`
-	} `json:"keyValueMetadata,omitempty"`
+	KeyValueMetadata *[]OutputKeyValueMetadata `json:"keyValueMetadata,omitempty"`
 
 	// KmsKeyId ID or ARN of the KMS customer-managed key to use for encryption
 	KmsKeyId *string `json:"kmsKeyId,omitempty"`
@@ -28812,22 +27251,7 @@ type OutputSplunkLb struct {
 	ExcludeSelf *bool `json:"excludeSelf,omitempty"`
 
 	// Hosts Set of Splunk indexers to load-balance data to.
-	Hosts []struct {
-		// Host The hostname of the receiver.
-		Host string `json:"host"`
-
-		// Port The port to connect to on the provided host.
-		Port float32 `json:"port"`
-
-		// Servername Servername to use if establishing a TLS connection. If not specified, defaults to connection host (iff not an IP); otherwise, to the global TLS settings.
-		Servername *string `json:"servername,omitempty"`
-
-		// Tls Whether to inherit TLS configs from group setting or disable TLS.
-		Tls *OutputSplunkLbHostsTls `json:"tls,omitempty"`
-
-		// Weight Assign a weight (>0) to each endpoint to indicate its traffic-handling capability
-		Weight *float32 `json:"weight,omitempty"`
-	} `json:"hosts"`
+	Hosts []OutputSplunkLbHost `json:"hosts"`
 
 	// Id Unique ID for this output
 	Id *string `json:"id,omitempty"`
@@ -28836,34 +27260,7 @@ type OutputSplunkLb struct {
 	IndexerDiscovery *OutputSplunkLbIndexerDiscovery `json:"indexerDiscovery,omitempty"`
 
 	// IndexerDiscoveryConfigs List of configurations to set up indexer discovery in Splunk Indexer clustering environment.
-	IndexerDiscoveryConfigs *struct {
-		// AuthToken Shared secret to be provided by any client (in authToken header field). If empty, unauthed access is permitted.
-		AuthToken *string `json:"authToken,omitempty"`
-
-		// AuthTokens Tokens required to authenticate to cluster manager for indexer discovery
-		AuthTokens *[]struct {
-			// AuthType Enter a token directly, or provide a secret referencing a token
-			AuthType *OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthType `json:"authType,omitempty"`
-		} `json:"authTokens,omitempty"`
-
-		// AuthType Enter a token directly, or provide a secret referencing a token
-		AuthType *OutputSplunkLbIndexerDiscoveryConfigsAuthType `json:"authType,omitempty"`
-
-		// MasterUri Full URI of Splunk cluster manager (scheme://host:port). E.g.: https://managerAddress:8089
-		MasterUri string `json:"masterUri"`
-
-		// RefreshIntervalSec Time interval, in seconds, between two consecutive indexer list fetches from cluster manager
-		RefreshIntervalSec float32 `json:"refreshIntervalSec"`
-
-		// RejectUnauthorized During indexer discovery, reject cluster manager certificates that are not authorized by the system's CA. Disable to allow untrusted (for example, self-signed) certificates.
-		RejectUnauthorized *bool `json:"rejectUnauthorized,omitempty"`
-
-		// Site Clustering site of the indexers from where indexers need to be discovered. In case of single site cluster, it defaults to 'default' site.
-		Site string `json:"site"`
-
-		// TextSecret Select or create a stored text secret
-		TextSecret *string `json:"textSecret,omitempty"`
-	} `json:"indexerDiscoveryConfigs,omitempty"`
+	IndexerDiscoveryConfigs *OutputSplunkLbIndexerDiscoveryConfigs `json:"indexerDiscoveryConfigs,omitempty"`
 
 	// LoadBalanceStatsPeriodSec How far back in time to keep traffic stats for load balancing purposes.
 	LoadBalanceStatsPeriodSec *float32 `json:"loadBalanceStatsPeriodSec,omitempty"`
@@ -28921,38 +27318,9 @@ type OutputSplunkLb struct {
 	TextSecret *string `json:"textSecret,omitempty"`
 
 	// ThrottleRatePerSec Rate (in bytes per second) to throttle while writing to an output. Also takes values with multiple-byte units, such as KB, MB, GB, etc. (E.g., 42 MB.) Default value of 0 specifies no throttling.
-	ThrottleRatePerSec *string `json:"throttleRatePerSec,omitempty"`
-	Tls                *struct {
-		// CaPath Path on client in which to find CA certificates to verify the server's cert. PEM format. Can reference $ENV_VARS.
-		CaPath *string `json:"caPath,omitempty"`
-
-		// CertPath Path on client in which to find certificates to use. PEM format. Can reference $ENV_VARS.
-		CertPath *string `json:"certPath,omitempty"`
-
-		// CertificateName The name of the predefined certificate.
-		CertificateName *string                    `json:"certificateName,omitempty"`
-		Disabled        *OutputSplunkLbTlsDisabled `json:"disabled,omitempty"`
-
-		// MaxVersion Maximum TLS version to use when connecting
-		MaxVersion *OutputSplunkLbTlsMaxVersion `json:"maxVersion,omitempty"`
-
-		// MinVersion Minimum TLS version to use when connecting
-		MinVersion *OutputSplunkLbTlsMinVersion `json:"minVersion,omitempty"`
-
-		// Passphrase Passphrase to use to decrypt private key.
-		Passphrase *string `json:"passphrase,omitempty"`
-
-		// PrivKeyPath Path on client in which to find the private key to use. PEM format. Can reference $ENV_VARS.
-		PrivKeyPath *string `json:"privKeyPath,omitempty"`
-
-		// RejectUnauthorized Reject certs that are not authorized by a CA in the CA certificate path, or by another
-		//                     trusted CA (e.g., the system's CA). Defaults to Yes. Overrides the toggle from Advanced Settings, when also present.
-		RejectUnauthorized *bool `json:"rejectUnauthorized,omitempty"`
-
-		// Servername Server name for the SNI (Server Name Indication) TLS extension. It must be a host name, and not an IP address.
-		Servername *string `json:"servername,omitempty"`
-	} `json:"tls,omitempty"`
-	Type OutputSplunkLbType `json:"type"`
+	ThrottleRatePerSec *string              `json:"throttleRatePerSec,omitempty"`
+	Tls                *OutputTlsClientSide `json:"tls,omitempty"`
+	Type               OutputSplunkLbType   `json:"type"`
 
 	// WriteTimeout Amount of time (milliseconds) to wait for a write to complete before assuming connection is dead
 	WriteTimeout *float32 `json:"writeTimeout,omitempty"`
@@ -28964,18 +27332,9 @@ type OutputSplunkLbAuthType string
 // OutputSplunkLbEnableACK Check if indexer is shutting down and stop sending data. This helps minimize data loss during shutdown.
 type OutputSplunkLbEnableACK bool
 
-// OutputSplunkLbHostsTls Whether to inherit TLS configs from group setting or disable TLS.
-type OutputSplunkLbHostsTls string
-
 // OutputSplunkLbIndexerDiscovery Automatically discover indexers in indexer clustering environment.
 type OutputSplunkLbIndexerDiscovery bool
 
-// OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthType Enter a token directly, or provide a secret referencing a token
-type OutputSplunkLbIndexerDiscoveryConfigsAuthTokensAuthType string
-
-// OutputSplunkLbIndexerDiscoveryConfigsAuthType Enter a token directly, or provide a secret referencing a token
-type OutputSplunkLbIndexerDiscoveryConfigsAuthType string
-
 // OutputSplunkLbMaxS2Sversion The highest S2S protocol version to advertise during handshake.
 type OutputSplunkLbMaxS2Sversion string
 
@@ -28994,15 +27353,6 @@ type OutputSplunkLbPqMode string
 // OutputSplunkLbPqOnBackpressure Whether to block or drop events when the queue is exerting backpressure (full capacity or low disk). 'Block' is the same behavior as non-PQ blocking. 'Drop new data' throws away incoming data, while leaving the contents of the PQ unchanged.
 type OutputSplunkLbPqOnBackpressure string
 
-// OutputSplunkLbTlsDisabled defines model for OutputSplunkLb.Tls.Disabled.
-type OutputSplunkLbTlsDisabled bool
-
-// OutputSplunkLbTlsMaxVersion Maximum TLS version to use when connecting
-type OutputSplunkLbTlsMaxVersion string
-
-// OutputSplunkLbTlsMinVersion Minimum TLS version to use when connecting
-type OutputSplunkLbTlsMinVersion string
-
 // OutputSplunkLbType defines model for OutputSplunkLb.Type.
 type OutputSplunkLbType string
 
@@ -30024,13 +28374,7 @@ type OutputWebhook struct {
 	ExcludeSelf *bool `json:"excludeSelf,omitempty"`
 
 	// ExtraHttpHeaders Headers to add to all events. You can also add headers dynamically on a per-event basis in the __headers field, as explained [here](https://docs.cribl.io/stream/destinations-webhook/#internal-fields).
-	ExtraHttpHeaders *[]struct {
-		// Name Field name
-		Name *string `json:"name,omitempty"`
-
-		// Value Field value
-		Value string `json:"value"`
-	} `json:"extraHttpHeaders,omitempty"`
+	ExtraHttpHeaders *[]OutputExtraHttpHeader `json:"extraHttpHeaders,omitempty"`
 
 	// FailedRequestLoggingMode Data to log when a request fails. All headers are redacted by default, unless listed as safe headers below.
 	FailedRequestLoggingMode *OutputWebhookFailedRequestLoggingMode `json:"failedRequestLoggingMode,omitempty"`
@@ -30072,22 +28416,10 @@ type OutputWebhook struct {
 	Method *OutputWebhookMethod `json:"method,omitempty"`
 
 	// OauthHeaders Additional headers to send in the OAuth login request. @{product} will automatically add the content-type header 'application/x-www-form-urlencoded' when sending this request.
-	OauthHeaders *[]struct {
-		// Name OAuth header name
-		Name string `json:"name"`
-
-		// Value OAuth header value
-		Value string `json:"value"`
-	} `json:"oauthHeaders,omitempty"`
+	OauthHeaders *[]OauthHeader `json:"oauthHeaders,omitempty"`
 
 	// OauthParams Additional parameters to send in the OAuth login request. @{product} will combine the secret with these parameters, and will send the URL-encoded result in a POST request to the endpoint specified in the 'Login URL'. We'll automatically add the content-type header 'application/x-www-form-urlencoded' when sending this request.
-	OauthParams *[]struct {
-		// Name OAuth parameter name
-		Name string `json:"name"`
-
-		// Value OAuth parameter value
-		Value string `json:"value"`
-	} `json:"oauthParams,omitempty"`
+	OauthParams *[]OauthParam `json:"oauthParams,omitempty"`
 
 	// OnBackpressure Whether to block, drop, or queue events when all receivers are exerting backpressure.
 	OnBackpressure *OutputWebhookOnBackpressure `json:"onBackpressure,omitempty"`
@@ -30126,19 +28458,7 @@ type OutputWebhook struct {
 	ResponseHonorRetryAfterHeader *bool `json:"responseHonorRetryAfterHeader,omitempty"`
 
 	// ResponseRetrySettings Automatically retry after unsuccessful response status codes, such as 429 (Too Many Requests) or 503 (Service Unavailable).
-	ResponseRetrySettings *[]struct {
-		// BackoffRate Base for exponential backoff. A value of 2 (default) means Cribl Stream will retry after 2 seconds, then 4 seconds, then 8 seconds, etc.
-		BackoffRate *float32 `json:"backoffRate,omitempty"`
-
-		// HttpStatus The HTTP response status code that will trigger retries
-		HttpStatus float32 `json:"httpStatus"`
-
-		// InitialBackoff How long, in milliseconds, Cribl Stream should wait before initiating backoff. Maximum interval is 600,000 ms (10 minutes).
-		InitialBackoff *float32 `json:"initialBackoff,omitempty"`
-
-		// MaxBackoff The maximum backoff interval, in milliseconds, Cribl Stream should apply. Default (and minimum) is 10,000 ms (10 seconds); maximum is 180,000 ms (180 seconds).
-		MaxBackoff *float32 `json:"maxBackoff,omitempty"`
-	} `json:"responseRetrySettings,omitempty"`
+	ResponseRetrySettings *[]OutputResponseRetrySetting `json:"responseRetrySettings,omitempty"`
 
 	// SafeHeaders List of headers that are safe to log in plain text
 	SafeHeaders *[]string `json:"safeHeaders,omitempty"`
@@ -30157,49 +28477,12 @@ type OutputWebhook struct {
 	SystemFields *[]string `json:"systemFields,omitempty"`
 
 	// TextSecret Select or create a stored text secret
-	TextSecret           *string `json:"textSecret,omitempty"`
-	TimeoutRetrySettings *struct {
-		// BackoffRate Base for exponential backoff. A value of 2 (default) means Cribl Stream will retry after 2 seconds, then 4 seconds, then 8 seconds, etc.
-		BackoffRate *float32 `json:"backoffRate,omitempty"`
-
-		// InitialBackoff How long, in milliseconds, Cribl Stream should wait before initiating backoff. Maximum interval is 600,000 ms (10 minutes).
-		InitialBackoff *float32 `json:"initialBackoff,omitempty"`
-
-		// MaxBackoff The maximum backoff interval, in milliseconds, Cribl Stream should apply. Default (and minimum) is 10,000 ms (10 seconds); maximum is 180,000 ms (180 seconds).
-		MaxBackoff *float32 `json:"maxBackoff,omitempty"`
-
-		// TimeoutRetry Enable to retry on request timeout
-		TimeoutRetry OutputWebhookTimeoutRetrySettingsTimeoutRetry `json:"timeoutRetry"`
-	} `json:"timeoutRetrySettings,omitempty"`
+	TextSecret           *string                     `json:"textSecret,omitempty"`
+	TimeoutRetrySettings *OutputTimeoutRetrySettings `json:"timeoutRetrySettings,omitempty"`
 
 	// TimeoutSec Amount of time, in seconds, to wait for a request to complete before canceling it
-	TimeoutSec *float32 `json:"timeoutSec,omitempty"`
-	Tls        *struct {
-		// CaPath Path on client in which to find CA certificates to verify the server's cert. PEM format. Can reference $ENV_VARS.
-		CaPath *string `json:"caPath,omitempty"`
-
-		// CertPath Path on client in which to find certificates to use. PEM format. Can reference $ENV_VARS.
-		CertPath *string `json:"certPath,omitempty"`
-
-		// CertificateName The name of the predefined certificate.
-		CertificateName *string                   `json:"certificateName,omitempty"`
-		Disabled        *OutputWebhookTlsDisabled `json:"disabled,omitempty"`
-
-		// MaxVersion Maximum TLS version to use when connecting
-		MaxVersion *OutputWebhookTlsMaxVersion `json:"maxVersion,omitempty"`
-
-		// MinVersion Minimum TLS version to use when connecting
-		MinVersion *OutputWebhookTlsMinVersion `json:"minVersion,omitempty"`
-
-		// Passphrase Passphrase to use to decrypt private key.
-		Passphrase *string `json:"passphrase,omitempty"`
-
-		// PrivKeyPath Path on client in which to find the private key to use. PEM format. Can reference $ENV_VARS.
-		PrivKeyPath *string `json:"privKeyPath,omitempty"`
-
-		// Servername Server name for the SNI (Server Name Indication) TLS extension. It must be a host name, and not an IP address.
-		Servername *string `json:"servername,omitempty"`
-	} `json:"tls,omitempty"`
+	TimeoutSec *float32             `json:"timeoutSec,omitempty"`
+	Tls        *OutputTlsClientSide `json:"tls,omitempty"`
 
 	// Token Bearer token to include in the authorization header
 	Token *string `json:"token,omitempty"`
@@ -30215,14 +28498,8 @@ type OutputWebhook struct {
 	Type               OutputWebhookType `json:"type"`
 
 	// Url URL of a webhook endpoint to send events to, such as http://localhost:10200
-	Url  *string `json:"url,omitempty"`
-	Urls *[]struct {
-		// Url URL of a webhook endpoint to send events to, such as http://localhost:10200
-		Url string `json:"url"`
-
-		// Weight Assign a weight (>0) to each endpoint to indicate its traffic-handling capability
-		Weight *float32 `json:"weight,omitempty"`
-	} `json:"urls,omitempty"`
+	Url  *string                  `json:"url,omitempty"`
+	Urls *[]OutputLoadBalancedUrl `json:"urls,omitempty"`
 
 	// UseRoundRobinDns Enables round-robin DNS lookup. When a DNS server returns multiple addresses, @{product} will cycle through them in the order returned. For optimal performance, consider enabling this setting for non-load balanced destinations.
 	UseRoundRobinDns *bool `json:"useRoundRobinDns,omitempty"`
@@ -30258,18 +28535,6 @@ type OutputWebhookPqMode string
 // OutputWebhookPqOnBackpressure Whether to block or drop events when the queue is exerting backpressure (full capacity or low disk). 'Block' is the same behavior as non-PQ blocking. 'Drop new data' throws away incoming data, while leaving the contents of the PQ unchanged.
 type OutputWebhookPqOnBackpressure string
 
-// OutputWebhookTimeoutRetrySettingsTimeoutRetry Enable to retry on request timeout
-type OutputWebhookTimeoutRetrySettingsTimeoutRetry bool
-
-// OutputWebhookTlsDisabled defines model for OutputWebhook.Tls.Disabled.
-type OutputWebhookTlsDisabled bool
-
-// OutputWebhookTlsMaxVersion Maximum TLS version to use when connecting
-type OutputWebhookTlsMaxVersion string
-
-// OutputWebhookTlsMinVersion Minimum TLS version to use when connecting
-type OutputWebhookTlsMinVersion string
-
 // OutputWebhookType defines model for OutputWebhook.Type.
 type OutputWebhookType string
 
@@ -30324,32 +28589,9 @@ type ParserLibEntryType string
 type ParserMode string
 
 // Pipeline defines model for Pipeline.
-
-type PipelineConf struct {
-		// AsyncFuncTimeout Time (in ms) to wait for an async function to complete processing of a data item
-		AsyncFuncTimeout *int    `json:"asyncFuncTimeout,omitempty"`
-		Description      *string `json:"description,omitempty"`
-
-		// Functions List of Functions to pass data through
-		Functions *[]PipelineFunctionConf `json:"functions,omitempty"`
-		Groups    *map[string]struct {
-			// Description Short description of this group
-			Description *string `json:"description,omitempty"`
-
-			// Disabled Whether this group is disabled
-			Disabled *bool  `json:"disabled,omitempty"`
-			Name     string `json:"name"`
-		} `json:"groups,omitempty"`
-
-		// Output The output destination for events processed by this Pipeline
-		Output *string `json:"output,omitempty"`
-
-		// Streamtags Tags for filtering and grouping in @{product}
-		Streamtags *[]string `json:"streamtags,omitempty"`
-	}
 type Pipeline struct {
 	Conf PipelineConf `json:"conf"`
-	Id string `json:"id"`
+	Id   string       `json:"id"`
 }
 
 // PipelineFunctionConf defines model for PipelineFunctionConf.
@@ -30664,14 +28906,7 @@ type RouteDefinitions = []RouteConf
 type Routes struct {
 	// Comments Comments
 	Comments *[]Routes_Comments_Item `json:"comments,omitempty"`
-	Groups   *map[string]struct {
-		// Description Short description of this group
-		Description *string `json:"description,omitempty"`
-
-		// Disabled Whether this group is disabled
-		Disabled *bool  `json:"disabled,omitempty"`
-		Name     string `json:"name"`
-	} `json:"groups,omitempty"`
+	Groups   *map[string]RoutesGroup `json:"groups,omitempty"`
 
 	// Id Routes ID
 	Id *string `json:"id,omitempty"`
@@ -37123,6 +35358,13 @@ func (t LookupFile) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'id': %w", err)
 	}
 
+	if t.Mode != nil {
+		object["mode"], err = json.Marshal(t.Mode)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'mode': %w", err)
+		}
+	}
+
 	if t.Size != nil {
 		object["size"], err = json.Marshal(t.Size)
 		if err != nil {
@@ -37165,6 +35407,13 @@ func (t *LookupFile) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["mode"]; found {
+		err = json.Unmarshal(raw, &t.Mode)
+		if err != nil {
+			return fmt.Errorf("error reading 'mode': %w", err)
+		}
+	}
+
 	if raw, found := object["size"]; found {
 		err = json.Unmarshal(raw, &t.Size)
 		if err != nil {
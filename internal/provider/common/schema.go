@@ -0,0 +1,256 @@
+package common
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// attribute blocks shared between source and destination types
+
+// ClientTLSAttribute is the tls block for inputs and outputs that open
+// connections to a remote server.
+func ClientTLSAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "TLS settings for outgoing connections",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"disabled": schema.BoolAttribute{
+				Description: "Disable TLS",
+				Optional:    true,
+			},
+			"reject_unauthorized": schema.BoolAttribute{
+				Description: "Reject server certificates that are not authorized by a trusted CA",
+				Optional:    true,
+			},
+			"servername": schema.StringAttribute{
+				Description: "Server name for the SNI TLS extension. Must be a host name, not an IP address",
+				Optional:    true,
+			},
+			"certificate_name": schema.StringAttribute{
+				Description: "Name of a predefined certificate",
+				Optional:    true,
+			},
+			"ca_path": schema.StringAttribute{
+				Description: "Path on client containing CA certificates to verify the server's certificate, PEM format",
+				Optional:    true,
+			},
+			"priv_key_path": schema.StringAttribute{
+				Description: "Path on client containing the private key to use, PEM format",
+				Optional:    true,
+			},
+			"cert_path": schema.StringAttribute{
+				Description: "Path on client containing the certificates to use, PEM format",
+				Optional:    true,
+			},
+			"passphrase": schema.StringAttribute{
+				Description: "Passphrase to decrypt the private key",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"min_version": schema.StringAttribute{
+				Description: "Minimum TLS version to use, e.g. TLSv1.2",
+				Optional:    true,
+			},
+			"max_version": schema.StringAttribute{
+				Description: "Maximum TLS version to use, e.g. TLSv1.3",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// KafkaSaslAttribute configures SASL authentication to Kafka brokers. Setting
+// the block enables authentication.
+func KafkaSaslAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "SASL authentication to use when connecting to brokers. Using TLS is highly recommended",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"mechanism": schema.StringAttribute{
+				Description: "SASL mechanism, one of plain, scram-sha-256, scram-sha-512 or kerberos",
+				Optional:    true,
+			},
+			"auth_type": schema.StringAttribute{
+				Description: "manual to enter username and password, secret to reference a stored credentials secret",
+				Optional:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username to authenticate with",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password to authenticate with",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"credentials_secret": schema.StringAttribute{
+				Description: "Stored secret holding the username and password",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// KafkaSchemaRegistryAttribute configures a Confluent Schema Registry. The
+// output also takes the default schema ids used to encode events.
+func KafkaSchemaRegistryAttribute(output bool) schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"url": schema.StringAttribute{
+			Description: "URL of the schema registry, e.g. http://localhost:8081. Use https to connect over TLS",
+			Required:    true,
+		},
+		"connection_timeout": schema.Float32Attribute{
+			Description: "Milliseconds to wait for a schema registry connection to complete",
+			Optional:    true,
+		},
+		"request_timeout": schema.Float32Attribute{
+			Description: "Milliseconds to wait for the schema registry to respond to a request",
+			Optional:    true,
+		},
+		"max_retries": schema.Float32Attribute{
+			Description: "Maximum number of times to try fetching schemas",
+			Optional:    true,
+		},
+		"credentials_secret": schema.StringAttribute{
+			Description: "Stored secret holding basic auth credentials for the schema registry",
+			Optional:    true,
+		},
+		"tls": ClientTLSAttribute(),
+	}
+	if output {
+		attributes["default_key_schema_id"] = schema.Float32Attribute{
+			Description: "Schema id used to encode keys when __keySchemaIdOut is not set",
+			Optional:    true,
+		}
+		attributes["default_value_schema_id"] = schema.Float32Attribute{
+			Description: "Schema id used to encode _raw when __valueSchemaIdOut is not set",
+			Optional:    true,
+		}
+	}
+	return schema.SingleNestedAttribute{
+		Description: "Confluent Schema Registry settings. Setting the block enables the registry",
+		Optional:    true,
+		Attributes:  attributes,
+	}
+}
+
+// HTTPAuthAttributes are the flat authentication attributes of HTTP based
+// inputs and outputs, they're merged into the resource's own attributes.
+func HTTPAuthAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"auth_type": schema.StringAttribute{
+			Description: "Authentication method, none, basic, credentialsSecret, token, textSecret or oauth",
+			Optional:    true,
+		},
+		"username": schema.StringAttribute{
+			Description: "Username for basic auth",
+			Optional:    true,
+		},
+		"password": schema.StringAttribute{
+			Description: "Password for basic auth",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"token": schema.StringAttribute{
+			Description: "Bearer token sent in the Authorization header, used with token",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"credentials_secret": schema.StringAttribute{
+			Description: "Stored secret holding the username and password, used with credentialsSecret",
+			Optional:    true,
+		},
+		"text_secret": schema.StringAttribute{
+			Description: "Stored text secret holding the bearer token, used with textSecret",
+			Optional:    true,
+		},
+		"login_url": schema.StringAttribute{
+			Description: "OAuth login URL, used with oauth",
+			Optional:    true,
+		},
+		"secret_param_name": schema.StringAttribute{
+			Description: "Name of the parameter carrying the secret in the OAuth login request",
+			Optional:    true,
+		},
+		"secret": schema.StringAttribute{
+			Description: "Secret sent in the OAuth login request",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"token_attribute_name": schema.StringAttribute{
+			Description: "Attribute of the OAuth response holding the token, e.g. token or data.token",
+			Optional:    true,
+		},
+		"auth_header_expr": schema.StringAttribute{
+			Description: "Expression computing the Authorization header from the OAuth token, e.g. `Bearer ${token}`",
+			Optional:    true,
+		},
+		"token_timeout_secs": schema.Float32Attribute{
+			Description: "Seconds between OAuth token refreshes",
+			Optional:    true,
+		},
+		"oauth_params":  oauthFieldsAttribute("Extra parameters to send in the OAuth login request"),
+		"oauth_headers": oauthFieldsAttribute("Extra headers to send in the OAuth login request"),
+	}
+}
+
+func oauthFieldsAttribute(description string) schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Name",
+					Required:    true,
+				},
+				"value": schema.StringAttribute{
+					Description: "Value",
+					Required:    true,
+					Sensitive:   true,
+				},
+			},
+		},
+	}
+}
+
+// AWSAuthAttributes are the flat AWS credential attributes of AWS based
+// inputs and outputs, they're merged into the resource's own attributes.
+func AWSAuthAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"aws_authentication_method": schema.StringAttribute{
+			Description: "AWS authentication method, auto to use IAM roles, manual for an access key or secret for a stored secret",
+			Optional:    true,
+		},
+		"aws_api_key": schema.StringAttribute{
+			Description: "AWS access key, used with manual",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"aws_secret_key": schema.StringAttribute{
+			Description: "AWS secret key, used with manual",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"aws_secret": schema.StringAttribute{
+			Description: "Stored secret holding the access key and secret key, used with secret",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"enable_assume_role": schema.BoolAttribute{
+			Description: "Use Assume Role credentials to access AWS",
+			Optional:    true,
+		},
+		"assume_role_arn": schema.StringAttribute{
+			Description: "Amazon Resource Name (ARN) of the role to assume",
+			Optional:    true,
+		},
+		"assume_role_external_id": schema.StringAttribute{
+			Description: "External ID to use when assuming role",
+			Optional:    true,
+		},
+		"duration_seconds": schema.Float32Attribute{
+			Description: "Duration of the assumed role's session in seconds, between 900 and 43200",
+			Optional:    true,
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package common
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+)
+
+// importedKey is the private state key set on import, so that the Read
+// following it keeps every attribute returned by Cribl.
+const importedKey = "imported"
+
+// ImportState imports a resource by its id and flags it as imported for the
+// next Read.
+func ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, []byte("true"))...)
+}
+
+// ReadBack limits state, just read from Cribl, to the attributes set in the
+// prior state, so that the defaults Cribl returns for unset attributes don't
+// show up as changes. Right after an import every attribute is kept. state
+// must be a pointer to the resource model.
+func ReadBack(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, state interface{}) {
+	imported, diags := req.Private.GetKey(ctx, importedKey)
+	resp.Diagnostics.Append(diags...)
+	if imported != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, nil)...)
+		return
+	}
+
+	prior := reflect.New(reflect.TypeOf(state).Elem()).Interface()
+	resp.Diagnostics.Append(req.State.Get(ctx, prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	models.ProjectState(prior, state)
+}
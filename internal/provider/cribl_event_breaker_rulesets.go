@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+)
+
+type criblEventBreakerRulesetResource struct {
+	client *cribl.Client
+}
+
+func NewCriblEventBreakerRulesetResource() resource.Resource {
+	return &criblEventBreakerRulesetResource{}
+}
+
+func (r *criblEventBreakerRulesetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_breaker_ruleset"
+}
+
+func (r *criblEventBreakerRulesetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom event breaker ruleset, referenced by Id from the breaker_rulesets of sources",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Ruleset Id",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Ruleset description",
+				Optional:    true,
+			},
+			"min_raw_length": schema.Float32Attribute{
+				Description: "Minimum number of characters in _raw to determine which rule to use",
+				Optional:    true,
+				Computed:    true,
+				Default:     float32default.StaticFloat32(256),
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags related to this ruleset",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Ordered list of rules. The first rule whose condition matches the data is used",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Rule name",
+							Required:    true,
+						},
+						"condition": schema.StringAttribute{
+							Description: "JavaScript filter expression that selects the data to apply the rule to",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("true"),
+						},
+						"type": schema.StringAttribute{
+							Description: "Event breaker type, e.g. regex, json_array, csv, timestamp or header",
+							Required:    true,
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "Disable this rule",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"event_breaker_regex": schema.StringAttribute{
+							Description: "Regex to break the stream into events on. Required when type is regex",
+							Optional:    true,
+						},
+						"timestamp_anchor_regex": schema.StringAttribute{
+							Description: "Regex to match before attempting timestamp extraction. Use $ to prevent extraction",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("/^/"),
+						},
+						"timestamp_type": schema.StringAttribute{
+							Description: "How to extract the timestamp: auto, format (strptime) or current time",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("auto"),
+						},
+						"timestamp_format": schema.StringAttribute{
+							Description: "strptime format of the timestamp. Required when timestamp_type is format",
+							Optional:    true,
+						},
+						"timestamp_length": schema.Float32Attribute{
+							Description: "Number of characters after the anchor to scan for a timestamp",
+							Optional:    true,
+							Computed:    true,
+							Default:     float32default.StaticFloat32(150),
+						},
+						"timestamp_timezone": schema.StringAttribute{
+							Description: "Timezone to assign to timestamps without timezone info",
+							Optional:    true,
+						},
+						"timestamp_earliest": schema.StringAttribute{
+							Description: "Earliest timestamp allowed relative to now, e.g. -42years. Earlier values are set to the current time",
+							Optional:    true,
+						},
+						"timestamp_latest": schema.StringAttribute{
+							Description: "Latest timestamp allowed relative to now, e.g. +42days. Later values are set to the current time",
+							Optional:    true,
+						},
+						"max_event_bytes": schema.Float32Attribute{
+							Description: "Maximum number of bytes in an event before it is flushed to the pipelines",
+							Optional:    true,
+							Computed:    true,
+							Default:     float32default.StaticFloat32(51200),
+						},
+						"parser_enabled": schema.BoolAttribute{
+							Description: "Parse the events with the parser of the rule type",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"should_use_data_raw": schema.BoolAttribute{
+							Description: "Mark events to use the _raw field of the data as event._raw in post processing",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"fields": schema.ListNestedAttribute{
+							Description: "Fields to add to each event",
+							Optional:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "Field name",
+										Required:    true,
+									},
+									"value": schema.StringAttribute{
+										Description: "JavaScript expression to compute the field's value, can be a constant",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *criblEventBreakerRulesetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.EventBreakerRuleset
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.Validate()...)
+}
+
+func (r *criblEventBreakerRulesetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.EventBreakerRuleset
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PostLibBreakers(ctx, plan.ToCriblEventBreakerRuleset(), r.client.RequestEditors...)
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating event breaker ruleset",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblEventBreakerRulesetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.EventBreakerRuleset
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PatchLibBreakersId(ctx, plan.ID.ValueString(), plan.ToCriblEventBreakerRuleset(), r.client.RequestEditors...)
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating event breaker ruleset",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblEventBreakerRulesetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.EventBreakerRuleset
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.DeleteLibBreakersId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err == nil && res.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete Cribl event breaker ruleset",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblEventBreakerRulesetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.EventBreakerRuleset
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetLibBreakersId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err == nil && res.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.EventBreakerRuleset `json:"items"`
+	}{}
+	if err := cribl.HandleResult(res, err, &tmp); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch event breaker ruleset from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to fetch event breaker ruleset from Cribl",
+			fmt.Sprintf("event breaker ruleset %q not found", state.ID.ValueString()),
+		)
+		return
+	}
+	state.FromCriblEventBreakerRuleset(tmp.Items[0])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblEventBreakerRulesetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *criblEventBreakerRulesetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
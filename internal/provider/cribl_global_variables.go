@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+)
+
+type criblGlobalVariableResource struct {
+	client *cribl.Client
+}
+
+func NewCriblGlobalVariableResource() resource.Resource {
+	return &criblGlobalVariableResource{}
+}
+
+func (r *criblGlobalVariableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_variable"
+}
+
+func (r *criblGlobalVariableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a global variable, referenced from expressions as C.vars.<id>",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Global variable name",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the variable, one of number, string, boolean, object, array, expression or encrypted_string",
+				Required:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the variable. Objects and arrays are JSON encoded. Required unless type is encrypted_string",
+				Optional:    true,
+			},
+			"encrypted_value": schema.StringAttribute{
+				Description: "Value of an encrypted_string variable. Cribl stores it encrypted, so changes made outside of Terraform are not detected",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Brief description of this variable",
+				Optional:    true,
+			},
+			"args": schema.ListNestedAttribute{
+				Description: "Arguments of an expression variable, referenced by name in its value",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Argument name",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Argument type",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags related to this variable",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *criblGlobalVariableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.GlobalVariable
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.Validate()...)
+}
+
+func (r *criblGlobalVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.GlobalVariable
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PostLibVars(ctx, plan.ToCriblGlobalVar(), r.client.RequestEditors...)
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating global variable",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblGlobalVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.GlobalVariable
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PatchLibVarsId(ctx, plan.ID.ValueString(), plan.ToCriblGlobalVar(), r.client.RequestEditors...)
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating global variable",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblGlobalVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.GlobalVariable
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.DeleteLibVarsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err == nil && res.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete Cribl global variable",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblGlobalVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.GlobalVariable
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetLibVarsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err == nil && res.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.GlobalVar `json:"items"`
+	}{}
+	if err := cribl.HandleResult(res, err, &tmp); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch global variable from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if len(tmp.Items) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(state.FromCriblGlobalVar(ctx, tmp.Items[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblGlobalVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *criblGlobalVariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
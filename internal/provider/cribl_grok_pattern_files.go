@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+)
+
+type criblGrokPatternFileResource struct {
+	client *cribl.Client
+}
+
+func NewCriblGrokPatternFileResource() resource.Resource {
+	return &criblGrokPatternFileResource{}
+}
+
+func (r *criblGrokPatternFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grok_pattern_file"
+}
+
+func (r *criblGrokPatternFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a grok pattern file, whose patterns can be referenced from grok functions and parsers",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Pattern file name",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "Pattern definitions, one NAME pattern pair per line",
+				Required:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags related to this pattern file",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *criblGrokPatternFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.GrokPatternFile
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PostLibGrok(ctx, plan.ToCriblGrokFile(), r.client.RequestEditors...)
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating grok pattern file",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblGrokPatternFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.GrokPatternFile
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PatchLibGrokId(ctx, plan.ID.ValueString(), plan.ToCriblGrokFile(), r.client.RequestEditors...)
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating grok pattern file",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblGrokPatternFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.GrokPatternFile
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.DeleteLibGrokId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err == nil && res.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete Cribl grok pattern file",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblGrokPatternFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.GrokPatternFile
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetLibGrokId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err == nil && res.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.GrokFile `json:"items"`
+	}{}
+	if err := cribl.HandleResult(res, err, &tmp); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch grok pattern file from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to fetch grok pattern file from Cribl",
+			fmt.Sprintf("grok pattern file %q not found", state.ID.ValueString()),
+		)
+		return
+	}
+	state.FromCriblGrokFile(tmp.Items[0])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblGrokPatternFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *criblGrokPatternFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/samber/lo"
+)
+
+type criblLookupResource struct {
+	client *cribl.Client
+}
+
+func NewCriblLookupResource() resource.Resource {
+	return &criblLookupResource{}
+}
+
+func (r *criblLookupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lookup"
+}
+
+func (r *criblLookupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a lookup file. The contents are set inline with content or uploaded from a local file, changes are detected through content_hash",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Lookup file name, e.g. ips.csv",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Lookup description",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags related to this lookup",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"mode": schema.StringAttribute{
+				Description: "memory loads the lookup into memory, disk keeps it on disk for lookups too large to fit in memory",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("memory"),
+			},
+			"content": schema.StringAttribute{
+				Description: "Lookup file contents. Conflicts with file",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"file": schema.StringAttribute{
+				Description: "Path to a local file to upload as the lookup. Conflicts with content",
+				Optional:    true,
+			},
+			"content_hash": schema.StringAttribute{
+				Description: "SHA256 of the lookup contents, used to detect changes without showing the contents in the plan",
+				Computed:    true,
+			},
+			"size": schema.Float32Attribute{
+				Description: "Lookup file size as reported by Cribl",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *criblLookupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.Lookup
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Mode.IsNull() && !data.Mode.IsUnknown() && !lo.Contains([]string{"memory", "disk"}, data.Mode.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mode"),
+			"Invalid lookup mode",
+			fmt.Sprintf("mode must be one of memory or disk, got %q.", data.Mode.ValueString()),
+		)
+	}
+
+	if data.Content.IsUnknown() || data.File.IsUnknown() {
+		return
+	}
+	if data.Content.IsNull() == data.File.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Invalid lookup contents",
+			"Exactly one of content or file must be set.",
+		)
+	}
+}
+
+// ModifyPlan hashes the contents so that a change shows up as a new
+// content_hash rather than as the whole file. size is only known ahead of
+// time when the contents are unchanged.
+func (r *criblLookupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan models.Lookup
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case plan.Content.IsUnknown() || plan.File.IsUnknown():
+		plan.ContentHash = types.StringUnknown()
+	case !plan.Content.IsNull():
+		plan.ContentHash = types.StringValue(models.LookupContentHash([]byte(plan.Content.ValueString())))
+	case !plan.File.IsNull():
+		data, err := os.ReadFile(plan.File.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("file"),
+				"Unable to read lookup file",
+				err.Error(),
+			)
+			return
+		}
+		plan.ContentHash = types.StringValue(models.LookupContentHash(data))
+	}
+
+	plan.Size = types.Float32Unknown()
+	if !req.State.Raw.IsNull() {
+		var state models.Lookup
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.ContentHash.Equal(state.ContentHash) {
+			plan.Size = state.Size
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *criblLookupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.Lookup
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookup, err := r.toCriblLookupFile(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to upload lookup file to Cribl",
+			err.Error(),
+		)
+		return
+	}
+	res, err := r.client.PostSystemLookups(ctx, lookup, r.client.RequestEditors...)
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating lookup",
+			err.Error(),
+		)
+		return
+	}
+
+	if plan.Size, err = r.getLookupSize(ctx, plan.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch lookup from Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblLookupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.Lookup
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookup, err := r.toCriblLookupFile(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to upload lookup file to Cribl",
+			err.Error(),
+		)
+		return
+	}
+	res, err := r.client.PatchSystemLookupsId(ctx, plan.ID.ValueString(), lookup, r.client.RequestEditors...)
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating lookup",
+			err.Error(),
+		)
+		return
+	}
+
+	if plan.Size, err = r.getLookupSize(ctx, plan.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch lookup from Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblLookupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.Lookup
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.DeleteSystemLookupsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err == nil && res.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete Cribl lookup",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblLookupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.Lookup
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookup, found, err := getLookup(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch lookup from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.FromCriblLookupFile(lookup)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblLookupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *criblLookupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// toCriblLookupFile builds the lookup request, uploading the local file
+// first when file is set.
+func (r *criblLookupResource) toCriblLookupFile(ctx context.Context, plan models.Lookup) (cribl.LookupFile, error) {
+	if plan.File.IsNull() {
+		return plan.ToCriblLookupFile("")
+	}
+	f, err := os.Open(plan.File.ValueString())
+	if err != nil {
+		return cribl.LookupFile{}, err
+	}
+	defer f.Close()
+
+	info := cribl.LookupFileInfoResponse{}
+	res, err := r.client.PutSystemLookupsWithBody(ctx, &cribl.PutSystemLookupsParams{
+		Filename: lo.ToPtr(filepath.Base(plan.File.ValueString())),
+	}, lookupContentType(plan.File.ValueString()), f, r.client.RequestEditors...)
+	if err := cribl.HandleResult(res, err, &info); err != nil {
+		return cribl.LookupFile{}, err
+	}
+	return plan.ToCriblLookupFile(info.Filename)
+}
+
+// lookupContentTypes are the content types of the lookup file formats Cribl
+// supports, other files are uploaded as application/octet-stream.
+var lookupContentTypes = map[string]string{
+	".csv": "text/csv",
+	".tsv": "text/tab-separated-values",
+	".gz":  "application/gzip",
+}
+
+func lookupContentType(name string) string {
+	if contentType, ok := lookupContentTypes[strings.ToLower(filepath.Ext(name))]; ok {
+		return contentType
+	}
+	return "application/octet-stream"
+}
+
+func (r *criblLookupResource) getLookupSize(ctx context.Context, id string) (types.Float32, error) {
+	lookup, found, err := getLookup(ctx, r.client, id)
+	if err != nil {
+		return types.Float32Null(), err
+	}
+	if !found {
+		return types.Float32Null(), fmt.Errorf("lookup %q not found", id)
+	}
+	return types.Float32PointerValue(lookup.Size), nil
+}
+
+func getLookup(ctx context.Context, client *cribl.Client, id string) (cribl.LookupFile, bool, error) {
+	tmp := struct {
+		Items []cribl.LookupFile `json:"items"`
+	}{}
+	res, err := client.GetSystemLookupsId(ctx, id, client.RequestEditors...)
+	if err == nil && res.StatusCode == http.StatusNotFound {
+		return cribl.LookupFile{}, false, nil
+	}
+	if err := cribl.HandleResult(res, err, &tmp); err != nil {
+		return cribl.LookupFile{}, false, err
+	}
+	if len(tmp.Items) == 0 {
+		return cribl.LookupFile{}, false, fmt.Errorf("lookup %q not found", id)
+	}
+	return tmp.Items[0], true, nil
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/samber/lo"
+)
+
+type criblParserResource struct {
+	client *cribl.Client
+}
+
+func NewCriblParserResource() resource.Resource {
+	return &criblParserResource{}
+}
+
+func (r *criblParserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_parser"
+}
+
+func (r *criblParserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an entry in the parsers library, referenced by Id from parser functions",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Parser Id",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Parser or formatter type, one of csv, elff, clf, kvp, json, delim, regex or grok",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Brief description of this parser",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags related to this parser",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"conf": schema.StringAttribute{
+				Description: "JSON encoded configuration of the parser type, e.g. its fields and delimiter",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *criblParserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.Parser
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Type.IsNull() && !data.Type.IsUnknown() && !lo.Contains(models.ParserTypes, cribl.ParserLibEntryType(data.Type.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid parser type",
+			fmt.Sprintf("type must be one of %v, got %q.", models.ParserTypes, data.Type.ValueString()),
+		)
+	}
+	if !data.Conf.IsNull() && !data.Conf.IsUnknown() {
+		conf := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(data.Conf.ValueString()), &conf); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("conf"),
+				"Invalid parser conf",
+				fmt.Sprintf("conf must be a JSON object: %v", err),
+			)
+		}
+		for _, key := range models.ParserEntryKeys {
+			if _, ok := conf[key]; ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("conf"),
+					"Invalid parser conf",
+					fmt.Sprintf("conf must not contain %q, it is a field of the parser entry itself rather than of its configuration.", key),
+				)
+			}
+		}
+	}
+}
+
+func (r *criblParserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.Parser
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parser, err := plan.ToCriblParserLibEntry()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to convert parser to Cribl request",
+			err.Error(),
+		)
+		return
+	}
+
+	res, err := r.client.PostLibParsers(ctx, parser, r.client.RequestEditors...)
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating parser",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblParserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.Parser
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parser, err := plan.ToCriblParserLibEntry()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to convert parser to Cribl request",
+			err.Error(),
+		)
+		return
+	}
+
+	res, err := r.client.PatchLibParsersId(ctx, plan.ID.ValueString(), parser, r.client.RequestEditors...)
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating parser",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblParserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.Parser
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.DeleteLibParsersId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err == nil && res.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete Cribl parser",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblParserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.Parser
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetLibParsersId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err == nil && res.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.ParserLibEntry `json:"items"`
+	}{}
+	if err := cribl.HandleResult(res, err, &tmp); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch parser from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to fetch parser from Cribl",
+			fmt.Sprintf("parser %q not found", state.ID.ValueString()),
+		)
+		return
+	}
+	if err := state.FromCriblParserLibEntry(tmp.Items[0]); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read parser from Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblParserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *criblParserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,503 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/samber/lo"
+)
+
+var (
+	regexLiteral = regexp.MustCompile(`^/.+/[dgimsuy]*$`)
+	timeWindow   = regexp.MustCompile(`^\d+[smhd]$`)
+)
+
+// pipelineFunctionAttributes are the typed function blocks that can be used
+// on a functions entry in place of id and conf.
+func pipelineFunctionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"eval": schema.SingleNestedAttribute{
+			Description: "Eval function. Adds, removes or keeps fields",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"add": schema.ListNestedAttribute{
+					Description: "Fields to add or update",
+					Optional:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Description: "Field name",
+								Required:    true,
+							},
+							"value": schema.StringAttribute{
+								Description: "JavaScript expression to compute the field's value",
+								Required:    true,
+							},
+							"disabled": schema.BoolAttribute{
+								Description: "Skip this field",
+								Optional:    true,
+							},
+						},
+					},
+				},
+				"remove": schema.ListAttribute{
+					Description: "Fields to remove. Supports wildcards",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"keep": schema.ListAttribute{
+					Description: "Fields to keep, takes precedence over remove. Supports wildcards",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+			},
+		},
+		"mask": schema.SingleNestedAttribute{
+			Description: "Mask function. Replaces matches of regular expressions",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"rules": schema.ListNestedAttribute{
+					Description: "Masking rules, applied in order",
+					Required:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"match_regex": schema.StringAttribute{
+								Description: "Regex literal to match, e.g. /\\d{16}/g",
+								Required:    true,
+							},
+							"replace_expr": schema.StringAttribute{
+								Description: "JavaScript expression for the replacement",
+								Required:    true,
+							},
+							"disabled": schema.BoolAttribute{
+								Description: "Skip this rule",
+								Optional:    true,
+							},
+						},
+					},
+				},
+				"fields": schema.ListAttribute{
+					Description: "Fields to apply the rules to. Defaults to _raw",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"depth": schema.Int64Attribute{
+					Description: "Depth to which wildcard fields are matched",
+					Optional:    true,
+				},
+			},
+		},
+		"drop": schema.SingleNestedAttribute{
+			Description: "Drop function. Drops every event that matches the function's filter",
+			Optional:    true,
+			Attributes:  map[string]schema.Attribute{},
+		},
+		"sampling": schema.SingleNestedAttribute{
+			Description: "Sampling function. Keeps one out of every rate matching events",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"rules": schema.ListNestedAttribute{
+					Description: "Sampling rules, the first match wins",
+					Required:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"filter": schema.StringAttribute{
+								Description: "JavaScript expression selecting the events to sample",
+								Required:    true,
+							},
+							"rate": schema.Int64Attribute{
+								Description: "Sampling rate, keeps 1 out of every rate events",
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"lookup": schema.SingleNestedAttribute{
+			Description: "Lookup function. Enriches events from a lookup table",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"file": schema.StringAttribute{
+					Description: "Lookup file name",
+					Required:    true,
+				},
+				"match_mode": schema.StringAttribute{
+					Description: "One of exact, cidr or regex",
+					Optional:    true,
+				},
+				"match_type": schema.StringAttribute{
+					Description: "For cidr and regex matching, one of first, specific or all",
+					Optional:    true,
+				},
+				"reload_period_sec": schema.Int64Attribute{
+					Description: "How often to reload the lookup file, -1 disables reloading",
+					Optional:    true,
+				},
+				"in_fields": schema.ListNestedAttribute{
+					Description: "Fields to match against the lookup table",
+					Required:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"event_field": schema.StringAttribute{
+								Description: "Event field",
+								Required:    true,
+							},
+							"lookup_field": schema.StringAttribute{
+								Description: "Lookup table column, defaults to event_field",
+								Optional:    true,
+							},
+						},
+					},
+				},
+				"out_fields": schema.ListNestedAttribute{
+					Description: "Lookup columns to add to matching events. Defaults to all columns",
+					Optional:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"lookup_field": schema.StringAttribute{
+								Description: "Lookup table column",
+								Required:    true,
+							},
+							"event_field": schema.StringAttribute{
+								Description: "Event field to write, defaults to lookup_field",
+								Optional:    true,
+							},
+							"default_value": schema.StringAttribute{
+								Description: "Value to use when there is no match",
+								Optional:    true,
+							},
+						},
+					},
+				},
+				"add_to_event": schema.BoolAttribute{
+					Description: "Add the output fields as index-time fields",
+					Optional:    true,
+				},
+				"ignore_case": schema.BoolAttribute{
+					Description: "Ignore case when matching",
+					Optional:    true,
+				},
+			},
+		},
+		"regex_extract": schema.SingleNestedAttribute{
+			Description: "Regex Extract function. Extracts fields using named capture groups",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"regex": schema.StringAttribute{
+					Description: "Regex literal with named capture groups, e.g. /(?<status>\\d{3})/",
+					Required:    true,
+				},
+				"regex_list": schema.ListAttribute{
+					Description: "Additional regex literals to apply",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"source": schema.StringAttribute{
+					Description: "Field to extract from. Defaults to _raw",
+					Optional:    true,
+				},
+				"iterations": schema.Int64Attribute{
+					Description: "Maximum number of times to apply the regex to the source",
+					Optional:    true,
+				},
+				"field_name_expression": schema.StringAttribute{
+					Description: "JavaScript expression to format the extracted field names",
+					Optional:    true,
+				},
+				"overwrite": schema.BoolAttribute{
+					Description: "Overwrite existing fields instead of creating arrays",
+					Optional:    true,
+				},
+			},
+		},
+		"parser": schema.SingleNestedAttribute{
+			Description: "Parser function. Extracts or reserializes fields from a structured field",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"mode": schema.StringAttribute{
+					Description: "One of extract or reserialize",
+					Required:    true,
+				},
+				"type": schema.StringAttribute{
+					Description: "One of csv, elff, clf, kvp, json, delim, regex or grok",
+					Required:    true,
+				},
+				"src_field": schema.StringAttribute{
+					Description: "Field containing the text to parse. Defaults to _raw",
+					Optional:    true,
+				},
+				"dst_field": schema.StringAttribute{
+					Description: "Field to write the parsed fields to",
+					Optional:    true,
+				},
+				"fields": schema.ListAttribute{
+					Description: "Field names for positional formats such as csv",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"keep": schema.ListAttribute{
+					Description: "Fields to keep. Supports wildcards",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"remove": schema.ListAttribute{
+					Description: "Fields to remove. Supports wildcards",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"field_filter_expr": schema.StringAttribute{
+					Description: "JavaScript expression selecting the fields to keep",
+					Optional:    true,
+				},
+			},
+		},
+		"rename": schema.SingleNestedAttribute{
+			Description: "Rename function. Renames fields",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"rename": schema.ListNestedAttribute{
+					Description: "Fields to rename",
+					Optional:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"current_name": schema.StringAttribute{
+								Description: "Current field name",
+								Required:    true,
+							},
+							"new_name": schema.StringAttribute{
+								Description: "New field name",
+								Required:    true,
+							},
+						},
+					},
+				},
+				"rename_expr": schema.StringAttribute{
+					Description: "JavaScript expression returning the new name of each field",
+					Optional:    true,
+				},
+				"base_fields": schema.ListAttribute{
+					Description: "Fields whose children are renamed",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"wildcard_depth": schema.Int64Attribute{
+					Description: "Depth to which wildcard fields are matched",
+					Optional:    true,
+				},
+			},
+		},
+		"serialize": schema.SingleNestedAttribute{
+			Description: "Serialize function. Serializes fields into a single field",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "One of csv, elff, clf, kvp, json or delim",
+					Required:    true,
+				},
+				"fields": schema.ListAttribute{
+					Description: "Fields to serialize. Required for csv, elff, clf and delim",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"src_field": schema.StringAttribute{
+					Description: "Field containing the object to serialize",
+					Optional:    true,
+				},
+				"dst_field": schema.StringAttribute{
+					Description: "Field to write the serialized value to. Defaults to _raw",
+					Optional:    true,
+				},
+			},
+		},
+		"aggregation": schema.SingleNestedAttribute{
+			Description: "Aggregations function. Computes statistics over time windows",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"time_window": schema.StringAttribute{
+					Description: "Window size, e.g. 10s or 5m",
+					Required:    true,
+				},
+				"aggregations": schema.ListAttribute{
+					Description: "Aggregate expressions, e.g. count() or sum(bytes).as(total)",
+					ElementType: types.StringType,
+					Required:    true,
+				},
+				"groupbys": schema.ListAttribute{
+					Description: "Fields to group aggregates by",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"cumulative": schema.BoolAttribute{
+					Description: "Keep aggregating across windows instead of resetting",
+					Optional:    true,
+				},
+				"prefix": schema.StringAttribute{
+					Description: "Prefix for the output field names",
+					Optional:    true,
+				},
+				"passthrough": schema.BoolAttribute{
+					Description: "Pass the original events through along with the aggregates",
+					Optional:    true,
+				},
+				"preserve_group_bys": schema.BoolAttribute{
+					Description: "Keep group by fields on the aggregated events",
+					Optional:    true,
+				},
+				"sufficient_stats_only": schema.BoolAttribute{
+					Description: "Only output sufficient statistics",
+					Optional:    true,
+				},
+				"flush_on_input_close": schema.BoolAttribute{
+					Description: "Flush aggregates when an input stream closes",
+					Optional:    true,
+				},
+			},
+		},
+		"suppress": schema.SingleNestedAttribute{
+			Description: "Suppress function. Suppresses events with the same key over a period",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"key_expr": schema.StringAttribute{
+					Description: "JavaScript expression building the suppression key",
+					Required:    true,
+				},
+				"allow": schema.Int64Attribute{
+					Description: "Number of events to allow per key per period",
+					Optional:    true,
+				},
+				"suppress_period_sec": schema.Int64Attribute{
+					Description: "Suppression period in seconds",
+					Optional:    true,
+				},
+				"drop_events_mode": schema.BoolAttribute{
+					Description: "Drop suppressed events instead of tagging them",
+					Optional:    true,
+				},
+				"max_cache_size": schema.Int64Attribute{
+					Description: "Maximum number of keys to track",
+					Optional:    true,
+				},
+				"cache_idle_timeout_periods": schema.Int64Attribute{
+					Description: "Periods without events after which a key is evicted",
+					Optional:    true,
+				},
+				"num_events_idle_timeout_trigger": schema.Int64Attribute{
+					Description: "Number of events after which idle keys are checked",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// validatePipelineFunction checks a functions entry at p: that it is
+// configured one way only, and that typed blocks hold settings cribl
+// accepts.
+func validatePipelineFunction(p path.Path, function models.PipelineFunction) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typed := function.TypedFunctionIDs()
+	switch {
+	case len(typed) > 1:
+		diags.AddAttributeError(p, "Conflicting function types",
+			fmt.Sprintf("Only one typed function block may be set, got %d.", len(typed)))
+		return diags
+	case len(typed) == 1 && !function.Conf.IsNull():
+		diags.AddAttributeError(p.AtName("conf"), "Conflicting function conf",
+			"conf can't be combined with a typed function block.")
+	case len(typed) == 1 && !function.ID.IsNull() && !function.ID.IsUnknown() && function.ID.ValueString() != typed[0]:
+		diags.AddAttributeError(p.AtName("id"), "Conflicting function id",
+			fmt.Sprintf("The typed block configures the %q function, omit id or set it to match.", typed[0]))
+	case len(typed) == 0 && function.ID.IsNull():
+		diags.AddAttributeError(p.AtName("id"), "Missing function id",
+			"id is required unless a typed function block is set.")
+	}
+
+	switch {
+	case function.Mask != nil:
+		for i, rule := range function.Mask.Rules {
+			diags.Append(validateRegexLiteral(p.AtName("mask").AtName("rules").AtListIndex(i).AtName("match_regex"), rule.MatchRegex)...)
+		}
+	case function.Sampling != nil:
+		for i, rule := range function.Sampling.Rules {
+			diags.Append(validateAtLeast(p.AtName("sampling").AtName("rules").AtListIndex(i).AtName("rate"), rule.Rate, 1)...)
+		}
+	case function.Lookup != nil:
+		diags.Append(validateOneOf(p.AtName("lookup").AtName("match_mode"), function.Lookup.MatchMode, "exact", "cidr", "regex")...)
+		diags.Append(validateOneOf(p.AtName("lookup").AtName("match_type"), function.Lookup.MatchType, "first", "specific", "all")...)
+		if len(function.Lookup.InFields) == 0 {
+			diags.AddAttributeError(p.AtName("lookup").AtName("in_fields"), "Missing lookup fields",
+				"At least one in_fields entry is required.")
+		}
+	case function.RegexExtract != nil:
+		diags.Append(validateRegexLiteral(p.AtName("regex_extract").AtName("regex"), function.RegexExtract.Regex)...)
+		for i, regex := range function.RegexExtract.RegexList {
+			diags.Append(validateRegexLiteral(p.AtName("regex_extract").AtName("regex_list").AtListIndex(i), regex)...)
+		}
+		diags.Append(validateAtLeast(p.AtName("regex_extract").AtName("iterations"), function.RegexExtract.Iterations, 1)...)
+	case function.Parser != nil:
+		diags.Append(validateOneOf(p.AtName("parser").AtName("mode"), function.Parser.Mode, "extract", "reserialize")...)
+		diags.Append(validateOneOf(p.AtName("parser").AtName("type"), function.Parser.Type, "csv", "elff", "clf", "kvp", "json", "delim", "regex", "grok")...)
+	case function.Rename != nil:
+		if len(function.Rename.Rename) == 0 && function.Rename.RenameExpr.IsNull() {
+			diags.AddAttributeError(p.AtName("rename"), "Missing rename settings",
+				"At least one of rename or rename_expr is required.")
+		}
+	case function.Serialize != nil:
+		diags.Append(validateOneOf(p.AtName("serialize").AtName("type"), function.Serialize.Type, "csv", "elff", "clf", "kvp", "json", "delim")...)
+		if lo.Contains([]string{"csv", "elff", "clf", "delim"}, function.Serialize.Type.ValueString()) && len(function.Serialize.Fields) == 0 {
+			diags.AddAttributeError(p.AtName("serialize").AtName("fields"), "Missing serialize fields",
+				fmt.Sprintf("fields is required for the %s format.", function.Serialize.Type.ValueString()))
+		}
+	case function.Aggregation != nil:
+		if tw := function.Aggregation.TimeWindow; !tw.IsUnknown() && !timeWindow.MatchString(tw.ValueString()) {
+			diags.AddAttributeError(p.AtName("aggregation").AtName("time_window"), "Invalid time window",
+				fmt.Sprintf("%q is not a window such as 10s, 5m, 1h or 1d.", tw.ValueString()))
+		}
+		if len(function.Aggregation.Aggregations) == 0 {
+			diags.AddAttributeError(p.AtName("aggregation").AtName("aggregations"), "Missing aggregations",
+				"At least one aggregate expression is required.")
+		}
+	case function.Suppress != nil:
+		diags.Append(validateAtLeast(p.AtName("suppress").AtName("allow"), function.Suppress.Allow, 1)...)
+		diags.Append(validateAtLeast(p.AtName("suppress").AtName("suppress_period_sec"), function.Suppress.SuppressPeriodSec, 1)...)
+	}
+	return diags
+}
+
+func validateOneOf(p path.Path, value types.String, allowed ...string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() || lo.Contains(allowed, value.ValueString()) {
+		return diags
+	}
+	diags.AddAttributeError(p, "Invalid value",
+		fmt.Sprintf("%q must be one of %s.", value.ValueString(), strings.Join(allowed, ", ")))
+	return diags
+}
+
+func validateAtLeast(p path.Path, value types.Int64, min int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() || value.ValueInt64() >= min {
+		return diags
+	}
+	diags.AddAttributeError(p, "Invalid value",
+		fmt.Sprintf("Must be at least %d, got %d.", min, value.ValueInt64()))
+	return diags
+}
+
+func validateRegexLiteral(p path.Path, value types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() || regexLiteral.MatchString(value.ValueString()) {
+		return diags
+	}
+	diags.AddAttributeError(p, "Invalid regex",
+		fmt.Sprintf("%q must be a regex literal such as /pattern/flags.", value.ValueString()))
+	return diags
+}
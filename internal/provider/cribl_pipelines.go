@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -44,40 +46,136 @@ func (r *criblPipelineResource) Schema(ctx context.Context, req resource.SchemaR
 				Description: "Pipeline output",
 				Required:    true,
 			},
-			"tags": schema.ListAttribute{
-				Description: "Pipeline tags",
+			"stream_tags": schema.ListAttribute{
+				Description: "Tags for filtering and grouping in Cribl",
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"tags": schema.ListAttribute{
+				Description:        "Pipeline tags",
+				ElementType:        types.StringType,
+				Optional:           true,
+				DeprecationMessage: "Use stream_tags instead.",
+			},
+			"functions": schema.ListNestedAttribute{
+				Description: "Ordered list of functions to pass data through",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: lo.Assign(map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Function Id, e.g. eval or mask. Required unless a typed function block is set",
+							Optional:    true,
+						},
+						"filter": schema.StringAttribute{
+							Description: "Filter that selects data to be fed through this function",
+							Optional:    true,
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "If true, data will not be pushed through this function",
+							Optional:    true,
+						},
+						"final": schema.BoolAttribute{
+							Description: "If enabled, stops the results of this function from being passed to the downstream functions",
+							Optional:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Simple description of this step",
+							Optional:    true,
+						},
+						"group_id": schema.StringAttribute{
+							Description: "Key of the entry in groups this function belongs to",
+							Optional:    true,
+						},
+						"conf": schema.StringAttribute{
+							Description: "JSON encoded function configuration. Conflicts with the typed function blocks",
+							Optional:    true,
+						},
+					}, pipelineFunctionAttributes()),
+				},
+			},
+			"groups": schema.MapNestedAttribute{
+				Description: "Function groups, keyed by group Id. Disabling a group disables every function in it",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Group name",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Short description of this group",
+							Optional:    true,
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "Whether this group is disabled",
+							Optional:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (c *criblPipelineResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.Pipeline
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.StreamTags.IsNull() && !data.Tags.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tags"),
+			"Conflicting pipeline tags",
+			"Only one of stream_tags or tags can be set, tags is deprecated.",
+		)
+	}
+
+	for i, function := range data.Functions {
+		resp.Diagnostics.Append(validatePipelineFunction(path.Root("functions").AtListIndex(i), function)...)
+		if !function.GroupID.IsNull() && !function.GroupID.IsUnknown() {
+			if _, ok := data.Groups[function.GroupID.ValueString()]; !ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("functions").AtListIndex(i).AtName("group_id"),
+					"Unknown function group",
+					fmt.Sprintf("Group %q is not defined in groups.", function.GroupID.ValueString()),
+				)
+			}
+		}
+		if function.Conf.IsNull() || function.Conf.IsUnknown() {
+			continue
+		}
+		conf := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(function.Conf.ValueString()), &conf); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("functions").AtListIndex(i).AtName("conf"),
+				"Invalid function conf",
+				fmt.Sprintf("conf must be a JSON encoded object: %s", err),
+			)
+		}
+	}
+}
+
 func (c *criblPipelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan models.Pipeline
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var tags []string
-	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
-	if resp.Diagnostics.HasError() {
+	pipeline, err := plan.ToCriblPipeline()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to convert pipeline to Cribl request",
+			err.Error(),
+		)
 		return
 	}
 
-	r, err := c.client.PostPipelines(ctx, cribl.Pipeline{
-		Id: plan.ID.ValueString(),
-		Conf: cribl.PipelineConf{
-			AsyncFuncTimeout: lo.ToPtr(int(plan.TimeoutMS.ValueInt64())),
-			Description:      plan.Description.ValueStringPointer(),
-			//todo: fix streamtags - threw a 500 while calling into the api
-			//Streamtags:       lo.ToPtr(tags),
-			Output: plan.Output.ValueStringPointer(),
-		},
-	})
-	if err != nil || r.StatusCode > 400 {
+	r, err := c.client.PostPipelines(ctx, pipeline, c.client.RequestEditors...)
+	if err := cribl.HandleResult(r, err, nil); err != nil {
 		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error creating pipeline. Status %v", r.StatusCode),
+			"Error creating pipeline",
 			err.Error(),
 		)
 		return
@@ -93,25 +191,19 @@ func (c *criblPipelineResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	var tags []string
-	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
-	if resp.Diagnostics.HasError() {
+	pipeline, err := plan.ToCriblPipeline()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to convert pipeline to Cribl request",
+			err.Error(),
+		)
 		return
 	}
 
-	r, err := c.client.PatchPipelinesId(ctx, plan.ID.ValueString(), cribl.Pipeline{
-		Id: plan.ID.ValueString(),
-		Conf: cribl.PipelineConf{
-			AsyncFuncTimeout: lo.ToPtr(int(plan.TimeoutMS.ValueInt64())),
-			Description:      plan.Description.ValueStringPointer(),
-			//todo: fix streamtags - threw a 500 while calling into the api
-			//Streamtags:       lo.ToPtr(tags),
-			Output: plan.Output.ValueStringPointer(),
-		},
-	})
-	if err != nil || r.StatusCode == http.StatusInternalServerError {
+	r, err := c.client.PatchPipelinesId(ctx, plan.ID.ValueString(), pipeline, c.client.RequestEditors...)
+	if err := cribl.HandleResult(r, err, nil); err != nil {
 		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error updating pipeline. Status %v", r.StatusCode),
+			"Error updating pipeline",
 			err.Error(),
 		)
 		return
@@ -153,22 +245,25 @@ func (c *criblPipelineResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 	if pipelineRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
 		return
 	}
-	pipeline := cribl.Pipeline{}
-	if err := cribl.HandleResult(pipelineRes, err, &pipeline); err != nil {
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to unmarshal pipelines from Cribl",
-				err.Error(),
-			)
-			return
-		}
+	tmp := struct {
+		Items []cribl.Pipeline `json:"items"`
+	}{}
+	if err := cribl.HandleResult(pipelineRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to unmarshal pipelines from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
 	}
-	state.Description = types.StringValue(lo.FromPtr(pipeline.Conf.Description))
-	state.Output = types.StringValue(lo.FromPtr(pipeline.Conf.Output))
-	if pipeline.Conf.AsyncFuncTimeout != nil {
-		state.TimeoutMS = types.Int64Value(int64(*pipeline.Conf.AsyncFuncTimeout))
+	if err := state.FromCriblPipeline(tmp.Items[0]); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read pipeline from Cribl",
+			err.Error(),
+		)
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -1,5 +1,370 @@
 package provider
 
-type criblRouteResourceModel struct {
-	
-}
\ No newline at end of file
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/samber/lo"
+)
+
+// routesMu serializes read-modify-write cycles against the routing table so
+// that sibling cribl_route resources applied in parallel don't drop each
+// other's changes.
+var routesMu sync.Mutex
+
+type criblRouteResource struct {
+	client *cribl.Client
+}
+
+func NewCriblRouteResource() resource.Resource {
+	return &criblRouteResource{}
+}
+
+func (r *criblRouteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_route"
+}
+
+func (r *criblRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single entry of a Cribl routing table, leaving the other entries untouched",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Route Id",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"routes_id": schema.StringAttribute{
+				Description: "Id of the routing table the route belongs to",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Route name",
+				Required:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "JavaScript expression to select data to route",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("true"),
+			},
+			"pipeline": schema.StringAttribute{
+				Description: "Pipeline to send the matching data to",
+				Required:    true,
+			},
+			"output": schema.StringAttribute{
+				Description: "Output to send the processed data to, or an expression when enable_output_expression is set",
+				Optional:    true,
+			},
+			"final": schema.BoolAttribute{
+				Description: "Whether the event is consumed by this route (final), or cloned into it",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"enable_output_expression": schema.BoolAttribute{
+				Description: "Evaluate output as a JavaScript expression that returns the output name",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Route description",
+				Optional:    true,
+			},
+			"before": schema.StringAttribute{
+				Description: "Id of the route this route is placed directly before. Conflicts with after",
+				Optional:    true,
+			},
+			"after": schema.StringAttribute{
+				Description: "Id of the route this route is placed directly after. Conflicts with before. When neither is set, new routes are added to the end of the table, ahead of a final catch-all route",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *criblRouteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.Route
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.Before.IsNull() && !data.After.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("after"),
+			"Conflicting route position",
+			"Only one of before or after may be set.",
+		)
+	}
+}
+
+func (r *criblRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.Route
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routesMu.Lock()
+	defer routesMu.Unlock()
+
+	routes, err := getRoutes(ctx, r.client, plan.RoutesID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch routes from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	// placeRoute replaces an entry with the same id, which would take over a
+	// route that isn't managed by this resource
+	if lo.ContainsBy(routes.Routes, func(route cribl.RoutesRoute) bool {
+		return lo.FromPtr(route.Id) == plan.ID.ValueString()
+	}) {
+		resp.Diagnostics.AddError(
+			"Route already exists",
+			fmt.Sprintf("Route %q already exists in routing table %q, choose another id or remove the existing route.", plan.ID.ValueString(), plan.RoutesID.ValueString()),
+		)
+		return
+	}
+	if routes.Routes, err = placeRoute(routes.Routes, plan.ToCriblRoutesRoute(), plan.Before.ValueString(), plan.After.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to position route",
+			err.Error(),
+		)
+		return
+	}
+	if err := patchRoutes(ctx, r.client, plan.RoutesID.ValueString(), routes); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create route in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.Route
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routesMu.Lock()
+	defer routesMu.Unlock()
+
+	routes, err := getRoutes(ctx, r.client, plan.RoutesID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch routes from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if routes.Routes, err = placeRoute(routes.Routes, plan.ToCriblRoutesRoute(), plan.Before.ValueString(), plan.After.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to position route",
+			err.Error(),
+		)
+		return
+	}
+	if err := patchRoutes(ctx, r.client, plan.RoutesID.ValueString(), routes); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update route in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.Route
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routesMu.Lock()
+	defer routesMu.Unlock()
+
+	routes, err := getRoutes(ctx, r.client, state.RoutesID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch routes from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	routes.Routes = lo.Reject(routes.Routes, func(route cribl.RoutesRoute, _ int) bool {
+		return lo.FromPtr(route.Id) == state.ID.ValueString()
+	})
+	if err := patchRoutes(ctx, r.client, state.RoutesID.ValueString(), routes); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete route from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.Route
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, err := getRoutes(ctx, r.client, state.RoutesID.ValueString())
+	if errors.Is(err, errRoutesNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch routes from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	route, idx, ok := lo.FindIndexOf(routes.Routes, func(route cribl.RoutesRoute) bool {
+		return lo.FromPtr(route.Id) == state.ID.ValueString()
+	})
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.FromCriblRoutesRoute(route)
+	// record the actual neighbour of the route, so that a route moved
+	// outside of Terraform shows up as a change to before or after
+	if !state.Before.IsNull() {
+		state.Before = types.StringNull()
+		if idx+1 < len(routes.Routes) {
+			state.Before = types.StringPointerValue(routes.Routes[idx+1].Id)
+		}
+	}
+	if !state.After.IsNull() {
+		state.After = types.StringNull()
+		if idx > 0 {
+			state.After = types.StringPointerValue(routes.Routes[idx-1].Id)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// errRoutesNotFound is returned by getRoutes when the routing table doesn't
+// exist.
+var errRoutesNotFound = errors.New("routes not found")
+
+func getRoutes(ctx context.Context, client *cribl.Client, id string) (cribl.Routes, error) {
+	tmp := struct {
+		Items []cribl.Routes `json:"items"`
+	}{}
+	routesRes, err := client.GetRoutesId(ctx, id, client.RequestEditors...)
+	if err == nil && routesRes.StatusCode == http.StatusNotFound {
+		return cribl.Routes{}, fmt.Errorf("%w: %q", errRoutesNotFound, id)
+	}
+	if err := cribl.HandleResult(routesRes, err, &tmp); err != nil {
+		return cribl.Routes{}, err
+	}
+	if len(tmp.Items) == 0 {
+		return cribl.Routes{}, fmt.Errorf("%w: %q", errRoutesNotFound, id)
+	}
+	return tmp.Items[0], nil
+}
+
+func patchRoutes(ctx context.Context, client *cribl.Client, id string, routes cribl.Routes) error {
+	routes.Id = lo.ToPtr(id)
+	routesRes, err := client.PatchRoutesId(ctx, id, routes, client.RequestEditors...)
+	return cribl.HandleResult(routesRes, err, nil)
+}
+
+// placeRoute inserts route into routes, replacing any existing entry with the
+// same id. The route is placed directly before or after the anchor route when
+// one is given, otherwise it keeps its current position or is added to the
+// end of the table, ahead of a final catch-all route so that it still gets
+// data.
+func placeRoute(routes []cribl.RoutesRoute, route cribl.RoutesRoute, before, after string) ([]cribl.RoutesRoute, error) {
+	id := lo.FromPtr(route.Id)
+	_, current, found := lo.FindIndexOf(routes, func(r cribl.RoutesRoute) bool {
+		return lo.FromPtr(r.Id) == id
+	})
+	if found && before == "" && after == "" {
+		// keep anything Terraform doesn't manage on the entry, e.g. clones
+		route.AdditionalProperties = routes[current].AdditionalProperties
+		routes[current] = route
+		return routes, nil
+	}
+	if found {
+		route.AdditionalProperties = routes[current].AdditionalProperties
+	}
+	routes = lo.Reject(routes, func(r cribl.RoutesRoute, _ int) bool {
+		return lo.FromPtr(r.Id) == id
+	})
+
+	anchor, offset := before, 0
+	if after != "" {
+		anchor, offset = after, 1
+	}
+	if anchor == "" {
+		if n := len(routes); n > 0 && isCatchAllRoute(routes[n-1]) {
+			return lo.Splice(routes, n-1, route), nil
+		}
+		return append(routes, route), nil
+	}
+	_, idx, ok := lo.FindIndexOf(routes, func(r cribl.RoutesRoute) bool {
+		return lo.FromPtr(r.Id) == anchor
+	})
+	if !ok {
+		return nil, fmt.Errorf("anchor route %q not found in routing table", anchor)
+	}
+	return lo.Splice(routes, idx+offset, route), nil
+}
+
+// isCatchAllRoute reports whether route consumes all the data that reaches it,
+// like the default route at the end of a routing table.
+func isCatchAllRoute(route cribl.RoutesRoute) bool {
+	// cribl omits final when it is left at its default of true
+	return lo.FromPtr(route.Filter) == "true" && (route.Final == nil || *route.Final)
+}
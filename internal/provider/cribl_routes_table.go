@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+)
+
+type criblRoutesResource struct {
+	client *cribl.Client
+}
+
+func NewCriblRoutesResource() resource.Resource {
+	return &criblRoutesResource{}
+}
+
+func (r *criblRoutesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routes"
+}
+
+func (r *criblRoutesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritatively manages a whole Cribl routing table. Routes, groups and comments not in the configuration are removed. Do not combine with cribl_route on the same table",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Routing table Id",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"routes": schema.ListNestedAttribute{
+				Description: "Ordered list of routes. Events are evaluated against the routes in this order",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Route Id",
+							Required:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Route name",
+							Required:    true,
+						},
+						"filter": schema.StringAttribute{
+							Description: "JavaScript expression to select data to route",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("true"),
+						},
+						"pipeline": schema.StringAttribute{
+							Description: "Pipeline to send the matching data to",
+							Required:    true,
+						},
+						"output": schema.StringAttribute{
+							Description: "Output to send the processed data to, or an expression when enable_output_expression is set",
+							Optional:    true,
+						},
+						"final": schema.BoolAttribute{
+							Description: "Whether the event is consumed by this route (final), or cloned into it",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
+						},
+						"enable_output_expression": schema.BoolAttribute{
+							Description: "Evaluate output as a JavaScript expression that returns the output name",
+							Optional:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Route description",
+							Optional:    true,
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "Disable this route",
+							Optional:    true,
+						},
+						"group_id": schema.StringAttribute{
+							Description: "Key of the entry in groups this route belongs to",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"groups": schema.MapNestedAttribute{
+				Description: "Route groups, keyed by group Id",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Group name",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Short description of this group",
+							Optional:    true,
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "Whether this group is disabled",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"comments": schema.ListAttribute{
+				Description: "Comments displayed in the routing table",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *criblRoutesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.Routes
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, route := range data.Routes {
+		if route.ID.IsUnknown() || route.ID.IsNull() {
+			continue
+		}
+		if seen[route.ID.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("routes").AtListIndex(i).AtName("id"),
+				"Duplicate route id",
+				fmt.Sprintf("Route id %q is used more than once.", route.ID.ValueString()),
+			)
+		}
+		seen[route.ID.ValueString()] = true
+
+		if route.GroupID.IsNull() || route.GroupID.IsUnknown() {
+			continue
+		}
+		if _, ok := data.Groups[route.GroupID.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("routes").AtListIndex(i).AtName("group_id"),
+				"Unknown route group",
+				fmt.Sprintf("Group %q is not defined in groups.", route.GroupID.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *criblRoutesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.Routes
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, diags := plan.ToCriblRoutes(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routesMu.Lock()
+	defer routesMu.Unlock()
+
+	// the routing table always exists in Cribl, so creating it means
+	// replacing whatever is currently there
+	if err := patchRoutes(ctx, r.client, plan.ID.ValueString(), routes); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create routes in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblRoutesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.Routes
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, diags := plan.ToCriblRoutes(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routesMu.Lock()
+	defer routesMu.Unlock()
+
+	if err := patchRoutes(ctx, r.client, plan.ID.ValueString(), routes); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update routes in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only drops the routing table from state. Cribl can't run without
+// one, so the live table is left in place rather than emptied.
+func (r *criblRoutesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *criblRoutesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.Routes
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, err := getRoutes(ctx, r.client, state.ID.ValueString())
+	if errors.Is(err, errRoutesNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch routes from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(state.FromCriblRoutes(ctx, routes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblRoutesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *criblRoutesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -1 +1,221 @@
 package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+)
+
+type criblSecretResource struct {
+	client *cribl.Client
+}
+
+func NewCriblSecretResource() resource.Resource {
+	return &criblSecretResource{}
+}
+
+func (r *criblSecretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+func (r *criblSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a secret in the system secrets store. The secret values are write-only, which needs Terraform 1.11 or later. Bump secret_wo_version to send changed values",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Secret name, referenced by the secret attributes of sources and destinations",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secret_type": schema.StringAttribute{
+				Description: "text for a single value, keypair for an API key and secret key, credentials for a username and password",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Secret description",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags related to this secret",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"value_wo": schema.StringAttribute{
+				Description: "Secret value, when secret_type is text",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"api_key": schema.StringAttribute{
+				Description: "API key, when secret_type is keypair",
+				Optional:    true,
+			},
+			"secret_key_wo": schema.StringAttribute{
+				Description: "Secret key, when secret_type is keypair",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username, when secret_type is credentials",
+				Optional:    true,
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "Password, when secret_type is credentials",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"secret_wo_version": schema.Int64Attribute{
+				Description: "Change this to send the write-only values again, e.g. after rotating them",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *criblSecretResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.Secret
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.Validate()...)
+}
+
+func (r *criblSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config models.Secret
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret := withSecretValues(plan, config)
+	res, err := r.client.PostSystemSecrets(ctx, secret.ToCriblSecret(), r.client.RequestEditors...)
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating secret",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config models.Secret
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret := withSecretValues(plan, config)
+	res, err := r.client.PatchSystemSecretsId(ctx, plan.ID.ValueString(), secret.ToCriblSecret(), r.client.RequestEditors...)
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating secret",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *criblSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.Secret
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.DeleteSystemSecretsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err == nil && res.StatusCode == http.StatusNotFound {
+		return
+	}
+	if err := cribl.HandleResult(res, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete Cribl secret",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.Secret
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetSystemSecretsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err == nil && res.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.RestSecret `json:"items"`
+	}{}
+	if err := cribl.HandleResult(res, err, &tmp); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch secret from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if len(tmp.Items) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.FromCriblSecret(tmp.Items[0])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *criblSecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// withSecretValues copies the write-only values, which are only available in
+// the configuration, onto the plan.
+func withSecretValues(plan, config models.Secret) models.Secret {
+	plan.ValueWO = config.ValueWO
+	plan.SecretKeyWO = config.SecretKeyWO
+	plan.PasswordWO = config.PasswordWO
+	return plan
+}
@@ -0,0 +1,184 @@
+package inputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+)
+
+type criblInputResource struct {
+	client *cribl.Client
+}
+
+func NewCriblInputResource() resource.Resource {
+	return &criblInputResource{}
+}
+
+func (r *criblInputResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblInputResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_input"
+}
+
+func (r *criblInputResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl source of any type. Use this when there is no typed cribl_input_* resource for the source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Input Id",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Input type, e.g. syslog, http or kafka",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.DynamicAttribute{
+				Description: "Input settings as an object using Cribl's attribute names, e.g. { port = 9514, host = \"0.0.0.0\" }. Settings Cribl fills in with defaults are ignored unless set here",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *criblInputResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.Input
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, err := data.ToCriblInput()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PostSystemInputs(ctx, input, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create input in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.Input
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, err := data.ToCriblInput()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PatchSystemInputsId(ctx, data.ID.ValueString(), input, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update input in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.Input
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete input from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblInputResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.Input
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputRes, err := r.client.GetSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch input from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if inputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []json.RawMessage `json:"items"`
+	}{}
+	if err := cribl.HandleResult(inputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize input response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(state.FromCriblInput(ctx, tmp.Items[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblInputResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
 	"github.com/noodahl-org/cribl/internal/clients/cribl"
 	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
 )
 
 type criblInputDatagenResource struct {
@@ -45,6 +47,7 @@ func (r *criblInputDatagenResource) Metadata(_ context.Context, req resource.Met
 
 func (r *criblInputDatagenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl datagen source, which generates sample events",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Input Id",
@@ -66,6 +69,7 @@ func (r *criblInputDatagenResource) Schema(ctx context.Context, req resource.Sch
 				Description: "PQ Enabled",
 				Optional:    true,
 			},
+			"pq": pqAttribute(),
 			"samples": schema.ListNestedAttribute{
 				Description: "List of sample data configurations",
 				Optional:    true,
@@ -82,13 +86,9 @@ func (r *criblInputDatagenResource) Schema(ctx context.Context, req resource.Sch
 					},
 				},
 			},
-			// "streamtags": schema.ListAttribute{
-			// 	Description: "Stream Tags",
-			// 	Optional:    true,
-			// 	ElementType: types.StringType,
-			// },
+			"stream_tags": streamTagsAttribute(),
 			"type": schema.StringAttribute{
-				Description: "Input Type",
+				Description: "Input Type, always datagen",
 				Optional:    true,
 			},
 			"description": schema.StringAttribute{
@@ -99,15 +99,30 @@ func (r *criblInputDatagenResource) Schema(ctx context.Context, req resource.Sch
 				Description: "Send To Routes",
 				Optional:    true,
 			},
-			// "metadata": schema.ListAttribute{
-			// 	Description: "Metadata",
-			// 	Optional:    true,
-			// 	ElementType: types.StringType,
-			// },
+			"connections": connectionsAttribute(),
+			"metadata":    metadataAttribute(),
 		},
 	}
 }
 
+func (r *criblInputDatagenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.InputDatagen
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Type.IsNull() && !data.Type.IsUnknown() && data.Type.ValueString() != "datagen" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid input type",
+			fmt.Sprintf("type must be datagen when set, got %q.", data.Type.ValueString()),
+		)
+	}
+
+	resp.Diagnostics.Append(models.ValidateConnections(data.SendToRoutes, data.Connections)...)
+}
+
 func (r *criblInputDatagenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data models.InputDatagen
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -121,11 +136,12 @@ func (r *criblInputDatagenResource) Create(ctx context.Context, req resource.Cre
 			"Unable to marshal input request to Cribl destination obj",
 			err.Error(),
 		)
+		return
 	}
 	inputRes, err := r.client.PostSystemInputs(ctx, cribl.Input{
 		Union: json.RawMessage(inputBytes),
-	})
-	if err != nil || inputRes.StatusCode != http.StatusOK {
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create input datagen in Cribl",
 			err.Error(),
@@ -151,11 +167,10 @@ func (r *criblInputDatagenResource) Update(ctx context.Context, req resource.Upd
 		)
 		return
 	}
-
 	inputRes, err := r.client.PatchSystemInputsId(ctx, data.ID.ValueString(), cribl.Input{
 		Union: json.RawMessage(inputBytes),
-	})
-	if err != nil || inputRes.StatusCode != http.StatusOK {
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update input datagen in Cribl",
 			err.Error(),
@@ -167,20 +182,19 @@ func (r *criblInputDatagenResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *criblInputDatagenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var plan models.InputDatagen
-	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+	var state models.InputDatagen
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	_, err := r.client.DeleteSystemInputsId(ctx, plan.ID.ValueString(), r.client.RequestEditors...)
+	_, err := r.client.DeleteSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unabled to delete datagen input from Cribl",
+			"Unable to delete datagen input from Cribl",
 			err.Error(),
 		)
 	}
-
 }
 
 func (r *criblInputDatagenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -189,25 +203,35 @@ func (r *criblInputDatagenResource) Read(ctx context.Context, req resource.ReadR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
 	inputRes, err := r.client.GetSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
-	if err != nil || inputRes.StatusCode == http.StatusInternalServerError {
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to fetch input from Cribl",
 			err.Error(),
 		)
+		return
 	}
 	if inputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 	tmp := struct {
 		Items []cribl.InputDatagen `json:"items"`
 	}{}
-	if err := cribl.HandleResult(inputRes, err, &tmp); err != nil {
+	if err := cribl.HandleResult(inputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
 		resp.Diagnostics.AddError(
 			"Unable to deseralize input response from Cribl",
-			err.Error(),
+			fmt.Sprintf("%v", err),
 		)
+		return
 	}
 	state.FromCriblDagen(tmp.Items[0])
-	resp.Diagnostics.Append(req.State.Set(ctx, &state)...)
+	common.ReadBack(ctx, req, resp, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblInputDatagenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp)
 }
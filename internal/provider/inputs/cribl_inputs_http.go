@@ -0,0 +1,297 @@
+package inputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
+)
+
+type criblInputHttpResource struct {
+	client *cribl.Client
+}
+
+func NewCriblInputHttpResource() resource.Resource {
+	return &criblInputHttpResource{}
+}
+
+func (r *criblInputHttpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblInputHttpResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_input_http"
+}
+
+func (r *criblInputHttpResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl HTTP source that accepts events over the Cribl, Elasticsearch bulk and Splunk HEC APIs",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Input Id",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Disabled",
+				Optional:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "Optionally, enable this config only on a specified Git branch",
+				Optional:    true,
+			},
+			"pipeline": schema.StringAttribute{
+				Description: "Pipeline to process data from this source before sending it through the Routes",
+				Optional:    true,
+			},
+			"stream_tags": streamTagsAttribute(),
+			"send_to_routes": schema.BoolAttribute{
+				Description: "Send data to Routes, or directly to the Destinations in connections",
+				Optional:    true,
+			},
+			"connections": connectionsAttribute(),
+			"pq_enabled": schema.BoolAttribute{
+				Description: "Use a disk queue to minimize data loss when connected services block",
+				Optional:    true,
+			},
+			"pq": pqAttribute(),
+			"host": schema.StringAttribute{
+				Description: "Address to bind on, e.g. 0.0.0.0 for all IPv4 addresses",
+				Required:    true,
+			},
+			"port": schema.Float32Attribute{
+				Description: "Port to listen on",
+				Required:    true,
+			},
+			"auth_tokens":     authTokensAttribute(),
+			"auth_tokens_ext": authTokensExtAttribute(),
+			"tls":             tlsAttribute(),
+			"max_active_req": schema.Float32Attribute{
+				Description: "Maximum number of active requests per Worker Process, 0 for unlimited",
+				Optional:    true,
+			},
+			"max_requests_per_socket": schema.Int64Attribute{
+				Description: "Maximum number of requests per socket before the client is asked to close the connection, 0 for unlimited",
+				Optional:    true,
+			},
+			"enable_proxy_header": schema.BoolAttribute{
+				Description: "Keep the client's original IP from the x-forwarded-for header when connecting through a proxy",
+				Optional:    true,
+			},
+			"capture_headers": schema.BoolAttribute{
+				Description: "Add request headers to events, in the __headers field",
+				Optional:    true,
+			},
+			"activity_log_sample_rate": schema.Float32Attribute{
+				Description: "How often request activity is logged at the info level, e.g. 10 logs every 10th request",
+				Optional:    true,
+			},
+			"request_timeout": schema.Float32Attribute{
+				Description: "Seconds to wait for an incoming request to complete before aborting it, 0 to disable",
+				Optional:    true,
+			},
+			"socket_timeout": schema.Float32Attribute{
+				Description: "Seconds to wait before assuming an inactive socket has timed out, 0 to wait forever",
+				Optional:    true,
+			},
+			"keep_alive_timeout": schema.Float32Attribute{
+				Description: "Seconds to wait for additional data after the last response before closing the socket",
+				Optional:    true,
+			},
+			"enable_health_check": schema.BoolAttribute{
+				Description: "Expose a health check endpoint on this input",
+				Optional:    true,
+			},
+			"ip_allowlist_regex": schema.StringAttribute{
+				Description: "Regex matching IP addresses whose requests are processed, unless also denylisted",
+				Optional:    true,
+			},
+			"ip_denylist_regex": schema.StringAttribute{
+				Description: "Regex matching IP addresses whose requests are ignored. Takes precedence over the allowlist",
+				Optional:    true,
+			},
+			"cribl_api": schema.StringAttribute{
+				Description: "Absolute path to listen on for Cribl HTTP API requests, e.g. /cribl. Leave unset to disable",
+				Optional:    true,
+			},
+			"elastic_api": schema.StringAttribute{
+				Description: "Absolute path to listen on for Elasticsearch bulk API requests, e.g. /elastic. Leave unset to disable",
+				Optional:    true,
+			},
+			"splunk_hec_api": schema.StringAttribute{
+				Description: "Absolute path to listen on for Splunk HEC API requests, e.g. /services/collector. Leave unset to disable",
+				Optional:    true,
+			},
+			"splunk_hec_acks": schema.BoolAttribute{
+				Description: "Enable Splunk HEC acknowledgements",
+				Optional:    true,
+			},
+			"metadata": metadataAttribute(),
+		},
+	}
+}
+
+func (r *criblInputHttpResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.InputHttp
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attr, value := range map[string]types.String{
+		"cribl_api":      data.CriblAPI,
+		"elastic_api":    data.ElasticAPI,
+		"splunk_hec_api": data.SplunkHecAPI,
+	} {
+		if value.IsNull() || value.IsUnknown() || strings.HasPrefix(value.ValueString(), "/") {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attr),
+			"Invalid API path",
+			fmt.Sprintf("%s must be an absolute path starting with /, got %q.", attr, value.ValueString()),
+		)
+	}
+
+	resp.Diagnostics.Append(models.ValidateConnections(data.SendToRoutes, data.Connections)...)
+}
+
+func (r *criblInputHttpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.InputHttp
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputHttp())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PostSystemInputs(ctx, cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create input http in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputHttpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.InputHttp
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputHttp())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PatchSystemInputsId(ctx, data.ID.ValueString(), cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update input http in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputHttpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.InputHttp
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete http input from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblInputHttpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.InputHttp
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputRes, err := r.client.GetSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch input from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if inputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.InputHttp `json:"items"`
+	}{}
+	if err := cribl.HandleResult(inputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize input response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	state.FromCriblInputHttp(tmp.Items[0])
+	common.ReadBack(ctx, req, resp, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblInputHttpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp)
+}
@@ -0,0 +1,294 @@
+package inputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
+)
+
+type criblInputKafkaResource struct {
+	client *cribl.Client
+}
+
+func NewCriblInputKafkaResource() resource.Resource {
+	return &criblInputKafkaResource{}
+}
+
+func (r *criblInputKafkaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblInputKafkaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_input_kafka"
+}
+
+func (r *criblInputKafkaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl Kafka source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Input Id",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Disabled",
+				Optional:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "Optionally, enable this config only on a specified Git branch",
+				Optional:    true,
+			},
+			"pipeline": schema.StringAttribute{
+				Description: "Pipeline to process data from this source before sending it through the Routes",
+				Optional:    true,
+			},
+			"stream_tags": streamTagsAttribute(),
+			"send_to_routes": schema.BoolAttribute{
+				Description: "Send data to Routes, or directly to the Destinations in connections",
+				Optional:    true,
+			},
+			"connections": connectionsAttribute(),
+			"pq_enabled": schema.BoolAttribute{
+				Description: "Use a disk queue to minimize data loss when connected services block",
+				Optional:    true,
+			},
+			"pq": pqAttribute(),
+			"brokers": schema.ListAttribute{
+				Description: "Kafka bootstrap servers as host:port, port defaults to 9092",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"topics": schema.ListAttribute{
+				Description: "Topics to subscribe to. Cribl suggests a single topic per source",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Consumer group this source belongs to, defaults to Cribl",
+				Optional:    true,
+			},
+			"from_beginning": schema.BoolAttribute{
+				Description: "Read from the earliest available message when first subscribing to a topic",
+				Optional:    true,
+			},
+			"session_timeout": schema.Float32Attribute{
+				Description: "Milliseconds without heartbeats before the broker removes this consumer from the group",
+				Optional:    true,
+			},
+			"rebalance_timeout": schema.Float32Attribute{
+				Description: "Milliseconds each worker has to join the group after a rebalance has begun",
+				Optional:    true,
+			},
+			"heartbeat_interval": schema.Float32Attribute{
+				Description: "Milliseconds between heartbeats to the consumer coordinator, typically a third of session_timeout",
+				Optional:    true,
+			},
+			"auto_commit_interval": schema.Float32Attribute{
+				Description: "Milliseconds between offset commits. Offsets are committed after each batch when this and auto_commit_threshold are unset",
+				Optional:    true,
+			},
+			"auto_commit_threshold": schema.Float32Attribute{
+				Description: "Number of events that triggers an offset commit",
+				Optional:    true,
+			},
+			"max_bytes_per_partition": schema.Float32Attribute{
+				Description: "Maximum bytes returned per partition per fetch request. Must be at least the largest message size",
+				Optional:    true,
+			},
+			"max_bytes": schema.Float32Attribute{
+				Description: "Maximum bytes returned per fetch request",
+				Optional:    true,
+			},
+			"max_socket_errors": schema.Float32Attribute{
+				Description: "Number of network errors before the consumer recreates a socket",
+				Optional:    true,
+			},
+			"connection_timeout": schema.Float32Attribute{
+				Description: "Milliseconds to wait for a connection to complete",
+				Optional:    true,
+			},
+			"request_timeout": schema.Float32Attribute{
+				Description: "Milliseconds to wait for Kafka to respond to a request",
+				Optional:    true,
+			},
+			"max_retries": schema.Float32Attribute{
+				Description: "Maximum number of retries for failing messages, up to 100",
+				Optional:    true,
+			},
+			"max_back_off": schema.Float32Attribute{
+				Description: "Maximum milliseconds to wait between retries",
+				Optional:    true,
+			},
+			"initial_backoff": schema.Float32Attribute{
+				Description: "Initial retry delay in milliseconds",
+				Optional:    true,
+			},
+			"backoff_rate": schema.Float32Attribute{
+				Description: "Multiplier, 2-20, applied to the retry delay after each failure",
+				Optional:    true,
+			},
+			"authentication_timeout": schema.Float32Attribute{
+				Description: "Milliseconds to wait for Kafka to respond to an authentication request",
+				Optional:    true,
+			},
+			"reauthentication_threshold": schema.Float32Attribute{
+				Description: "Milliseconds before credentials expire during which Cribl may reauthenticate",
+				Optional:    true,
+			},
+			"sasl":            common.KafkaSaslAttribute(),
+			"tls":             common.ClientTLSAttribute(),
+			"schema_registry": common.KafkaSchemaRegistryAttribute(false),
+			"metadata":        metadataAttribute(),
+		},
+	}
+}
+
+func (r *criblInputKafkaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.InputKafka
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(models.ValidateConnections(data.SendToRoutes, data.Connections)...)
+}
+
+func (r *criblInputKafkaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.InputKafka
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputKafka())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PostSystemInputs(ctx, cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create input kafka in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputKafkaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.InputKafka
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputKafka())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PatchSystemInputsId(ctx, data.ID.ValueString(), cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update input kafka in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputKafkaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.InputKafka
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete kafka input from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblInputKafkaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.InputKafka
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputRes, err := r.client.GetSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch input from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if inputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.InputKafka `json:"items"`
+	}{}
+	if err := cribl.HandleResult(inputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize input response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	state.FromCriblInputKafka(tmp.Items[0])
+	common.ReadBack(ctx, req, resp, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblInputKafkaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp)
+}
@@ -0,0 +1,291 @@
+package inputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
+)
+
+type criblInputOpenTelemetryResource struct {
+	client *cribl.Client
+}
+
+func NewCriblInputOpenTelemetryResource() resource.Resource {
+	return &criblInputOpenTelemetryResource{}
+}
+
+func (r *criblInputOpenTelemetryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblInputOpenTelemetryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_input_open_telemetry"
+}
+
+func (r *criblInputOpenTelemetryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Input Id",
+			Required:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description",
+			Optional:    true,
+		},
+		"disabled": schema.BoolAttribute{
+			Description: "Disabled",
+			Optional:    true,
+		},
+		"environment": schema.StringAttribute{
+			Description: "Optionally, enable this config only on a specified Git branch",
+			Optional:    true,
+		},
+		"pipeline": schema.StringAttribute{
+			Description: "Pipeline to process data from this source before sending it through the Routes",
+			Optional:    true,
+		},
+		"stream_tags": streamTagsAttribute(),
+		"send_to_routes": schema.BoolAttribute{
+			Description: "Send data to Routes, or directly to the Destinations in connections",
+			Optional:    true,
+		},
+		"connections": connectionsAttribute(),
+		"pq_enabled": schema.BoolAttribute{
+			Description: "Use a disk queue to minimize data loss when connected services block",
+			Optional:    true,
+		},
+		"pq": pqAttribute(),
+		"host": schema.StringAttribute{
+			Description: "Address to bind on, e.g. 0.0.0.0 for all IPv4 addresses",
+			Required:    true,
+		},
+		"port": schema.Float32Attribute{
+			Description: "Port to listen on, 4317 is the standard OTLP gRPC port and 4318 the OTLP HTTP port",
+			Required:    true,
+		},
+		"protocol": schema.StringAttribute{
+			Description: "OTLP transport to accept, grpc or http",
+			Optional:    true,
+		},
+		"otlp_version": schema.StringAttribute{
+			Description: "OTLP protocol version to accept, 0.10.0 or 1.3.1",
+			Optional:    true,
+		},
+		"extract_spans": schema.BoolAttribute{
+			Description: "Extract each incoming span to a separate event",
+			Optional:    true,
+		},
+		"extract_metrics": schema.BoolAttribute{
+			Description: "Extract each incoming Gauge or IntGauge metric to one event per data point",
+			Optional:    true,
+		},
+		"extract_logs": schema.BoolAttribute{
+			Description: "Extract each incoming log record to a separate event",
+			Optional:    true,
+		},
+		"tls": tlsAttribute(),
+		"max_active_req": schema.Float32Attribute{
+			Description: "Maximum number of active requests per Worker Process, 0 for unlimited",
+			Optional:    true,
+		},
+		"max_requests_per_socket": schema.Int64Attribute{
+			Description: "Maximum number of requests per socket before the client is asked to close the connection, 0 for unlimited",
+			Optional:    true,
+		},
+		"max_active_cxn": schema.Float32Attribute{
+			Description: "Maximum number of active connections per Worker Process, 0 for unlimited",
+			Optional:    true,
+		},
+		"enable_proxy_header": schema.BoolAttribute{
+			Description: "Keep the client's original IP from the x-forwarded-for header when connecting through a proxy",
+			Optional:    true,
+		},
+		"capture_headers": schema.BoolAttribute{
+			Description: "Add request headers to events, in the __headers field",
+			Optional:    true,
+		},
+		"activity_log_sample_rate": schema.Float32Attribute{
+			Description: "How often request activity is logged at the info level, e.g. 10 logs every 10th request",
+			Optional:    true,
+		},
+		"request_timeout": schema.Float32Attribute{
+			Description: "Seconds to wait for an incoming request to complete before aborting it, 0 to disable",
+			Optional:    true,
+		},
+		"socket_timeout": schema.Float32Attribute{
+			Description: "Seconds to wait before assuming an inactive socket has timed out, 0 to wait forever",
+			Optional:    true,
+		},
+		"keep_alive_timeout": schema.Float32Attribute{
+			Description: "Seconds to wait for additional data after the last response before closing the socket",
+			Optional:    true,
+		},
+		"enable_health_check": schema.BoolAttribute{
+			Description: "Expose the /cribl_health endpoint on this input",
+			Optional:    true,
+		},
+		"ip_allowlist_regex": schema.StringAttribute{
+			Description: "Regex matching IP addresses whose requests are processed, unless also denylisted",
+			Optional:    true,
+		},
+		"ip_denylist_regex": schema.StringAttribute{
+			Description: "Regex matching IP addresses whose requests are ignored. Takes precedence over the allowlist",
+			Optional:    true,
+		},
+		"metadata": metadataAttribute(),
+	}
+	maps.Copy(attributes, common.HTTPAuthAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl OpenTelemetry source",
+		Attributes:  attributes,
+	}
+}
+
+func (r *criblInputOpenTelemetryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.InputOpenTelemetry
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.HTTPAuth.Validate()...)
+
+	resp.Diagnostics.Append(models.ValidateConnections(data.SendToRoutes, data.Connections)...)
+}
+
+func (r *criblInputOpenTelemetryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.InputOpenTelemetry
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputOpenTelemetry())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PostSystemInputs(ctx, cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create input open telemetry in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputOpenTelemetryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.InputOpenTelemetry
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputOpenTelemetry())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PatchSystemInputsId(ctx, data.ID.ValueString(), cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update input open telemetry in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputOpenTelemetryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.InputOpenTelemetry
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete open telemetry input from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblInputOpenTelemetryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.InputOpenTelemetry
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputRes, err := r.client.GetSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch input from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if inputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.InputOpenTelemetry `json:"items"`
+	}{}
+	if err := cribl.HandleResult(inputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize input response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	state.FromCriblInputOpenTelemetry(tmp.Items[0])
+	common.ReadBack(ctx, req, resp, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblInputOpenTelemetryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp)
+}
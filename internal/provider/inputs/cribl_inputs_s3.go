@@ -0,0 +1,307 @@
+package inputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
+)
+
+type criblInputS3Resource struct {
+	client *cribl.Client
+}
+
+func NewCriblInputS3Resource() resource.Resource {
+	return &criblInputS3Resource{}
+}
+
+func (r *criblInputS3Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblInputS3Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_input_s3"
+}
+
+func (r *criblInputS3Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Input Id",
+			Required:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description",
+			Optional:    true,
+		},
+		"disabled": schema.BoolAttribute{
+			Description: "Disabled",
+			Optional:    true,
+		},
+		"environment": schema.StringAttribute{
+			Description: "Optionally, enable this config only on a specified Git branch",
+			Optional:    true,
+		},
+		"pipeline": schema.StringAttribute{
+			Description: "Pipeline to process data from this source before sending it through the Routes",
+			Optional:    true,
+		},
+		"stream_tags": streamTagsAttribute(),
+		"send_to_routes": schema.BoolAttribute{
+			Description: "Send data to Routes, or directly to the Destinations in connections",
+			Optional:    true,
+		},
+		"connections": connectionsAttribute(),
+		"pq_enabled": schema.BoolAttribute{
+			Description: "Use a disk queue to minimize data loss when connected services block",
+			Optional:    true,
+		},
+		"pq": pqAttribute(),
+		"queue_name": schema.StringAttribute{
+			Description: "Name, URL or ARN of the SQS queue to read S3 notifications from",
+			Required:    true,
+		},
+		"aws_account_id": schema.StringAttribute{
+			Description: "SQS queue owner's AWS account ID. Leave empty if the queue is in the same account",
+			Optional:    true,
+		},
+		"region": schema.StringAttribute{
+			Description: "AWS region the queue is located in",
+			Optional:    true,
+		},
+		"endpoint": schema.StringAttribute{
+			Description: "Service endpoint. If empty, defaults to the AWS regional endpoint",
+			Optional:    true,
+		},
+		"signature_version": schema.StringAttribute{
+			Description: "Signature version to use for signing requests, v2 or v4",
+			Optional:    true,
+		},
+		"reuse_connections": schema.BoolAttribute{
+			Description: "Reuse connections between requests, which can improve performance",
+			Optional:    true,
+		},
+		"reject_unauthorized": schema.BoolAttribute{
+			Description: "Reject certificates that cannot be verified against a valid CA",
+			Optional:    true,
+		},
+		"enable_sqs_assume_role": schema.BoolAttribute{
+			Description: "Use the assumed role when accessing SQS",
+			Optional:    true,
+		},
+		"file_filter": schema.StringAttribute{
+			Description: "Regex matching file names to download and process",
+			Optional:    true,
+		},
+		"breaker_rulesets": schema.ListAttribute{
+			Description: "Event breaker rulesets to apply to the downloaded files, in order",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"stale_channel_flush_ms": schema.Float32Attribute{
+			Description: "Milliseconds of inactivity after which the event breaker flushes buffered data",
+			Optional:    true,
+		},
+		"encoding": schema.StringAttribute{
+			Description: "Character encoding of the files, e.g. utf8",
+			Optional:    true,
+		},
+		"preprocess": preprocessAttribute(),
+		"checkpointing": schema.SingleNestedAttribute{
+			Description: "Resume processing files after an interruption. Setting this block enables checkpointing",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"retries": schema.Float32Attribute{
+					Description: "Number of times to retry processing a file when a checkpoint is available",
+					Optional:    true,
+				},
+			},
+		},
+		"skip_on_error": schema.BoolAttribute{
+			Description: "Skip files that fail to download instead of retrying them",
+			Optional:    true,
+		},
+		"max_messages": schema.Float32Attribute{
+			Description: "Maximum number of messages SQS should return in a poll request, 1 to 10",
+			Optional:    true,
+		},
+		"visibility_timeout": schema.Float32Attribute{
+			Description: "Seconds a received message stays hidden from other receivers before it is returned to the queue",
+			Optional:    true,
+		},
+		"num_receivers": schema.Float32Attribute{
+			Description: "Number of receiver processes to run per Worker Process",
+			Optional:    true,
+		},
+		"poll_timeout": schema.Float32Attribute{
+			Description: "Seconds to wait for events before polling again",
+			Optional:    true,
+		},
+		"socket_timeout": schema.Float32Attribute{
+			Description: "Seconds to wait on a socket before giving up on a download",
+			Optional:    true,
+		},
+		"parquet_chunk_size_mb": schema.Float32Attribute{
+			Description: "Maximum size of a Parquet chunk to download, in MB",
+			Optional:    true,
+		},
+		"parquet_chunk_download_timeout": schema.Float32Attribute{
+			Description: "Seconds to wait for a Parquet chunk to download",
+			Optional:    true,
+		},
+		"metadata": metadataAttribute(),
+	}
+	maps.Copy(attributes, common.AWSAuthAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl Amazon S3 source, fed by S3 event notifications on an SQS queue",
+		Attributes:  attributes,
+	}
+}
+
+func (r *criblInputS3Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.InputS3
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.AWSAuth.Validate()...)
+
+	resp.Diagnostics.Append(models.ValidateConnections(data.SendToRoutes, data.Connections)...)
+}
+
+func (r *criblInputS3Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.InputS3
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputS3())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PostSystemInputs(ctx, cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create input s3 in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputS3Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.InputS3
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputS3())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PatchSystemInputsId(ctx, data.ID.ValueString(), cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update input s3 in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputS3Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.InputS3
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete s3 input from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblInputS3Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.InputS3
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputRes, err := r.client.GetSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch input from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if inputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.InputS3 `json:"items"`
+	}{}
+	if err := cribl.HandleResult(inputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize input response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	state.FromCriblInputS3(tmp.Items[0])
+	common.ReadBack(ctx, req, resp, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblInputS3Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp)
+}
@@ -0,0 +1,381 @@
+package inputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
+)
+
+type criblInputSplunkHecResource struct {
+	client *cribl.Client
+}
+
+func NewCriblInputSplunkHecResource() resource.Resource {
+	return &criblInputSplunkHecResource{}
+}
+
+func (r *criblInputSplunkHecResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblInputSplunkHecResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_input_splunk_hec"
+}
+
+func (r *criblInputSplunkHecResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl Splunk HEC source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Input Id",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Disabled",
+				Optional:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "Optionally, enable this config only on a specified Git branch",
+				Optional:    true,
+			},
+			"pipeline": schema.StringAttribute{
+				Description: "Pipeline to process data from this source before sending it through the Routes",
+				Optional:    true,
+			},
+			"stream_tags": streamTagsAttribute(),
+			"send_to_routes": schema.BoolAttribute{
+				Description: "Send data to Routes, or directly to the Destinations in connections",
+				Optional:    true,
+			},
+			"connections": connectionsAttribute(),
+			"pq_enabled": schema.BoolAttribute{
+				Description: "Use a disk queue to minimize data loss when connected services block",
+				Optional:    true,
+			},
+			"pq": pqAttribute(),
+			"host": schema.StringAttribute{
+				Description: "Address to bind on, e.g. 0.0.0.0 for all IPv4 addresses",
+				Required:    true,
+			},
+			"port": schema.Float32Attribute{
+				Description: "Port to listen on",
+				Required:    true,
+			},
+			"auth_tokens": schema.ListNestedAttribute{
+				Description: "Shared secrets clients must provide in the Authorization header. If empty, unauthorized access is permitted",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"auth_type": schema.StringAttribute{
+							Description: "manual to enter the token directly, secret to reference a stored secret",
+							Optional:    true,
+						},
+						"token": schema.StringAttribute{
+							Description: "Shared secret clients must provide, used when auth_type is manual",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"token_secret": schema.StringAttribute{
+							Description: "Name of the secret holding the token, used when auth_type is secret",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Token description",
+							Optional:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Accept requests carrying this token",
+							Optional:    true,
+						},
+						"allowed_indexes": schema.ListAttribute{
+							Description: "Values allowed in the HEC event index field for this token. Supports wildcards",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"metadata": metadataAttribute(),
+					},
+				},
+			},
+			"tls": tlsAttribute(),
+			"max_active_req": schema.Float32Attribute{
+				Description: "Maximum number of active requests per Worker Process, 0 for unlimited",
+				Optional:    true,
+			},
+			"max_requests_per_socket": schema.Int64Attribute{
+				Description: "Maximum number of requests per socket before the client is asked to close the connection, 0 for unlimited",
+				Optional:    true,
+			},
+			"enable_proxy_header": schema.BoolAttribute{
+				Description: "Keep the client's original IP from the x-forwarded-for header when connecting through a proxy",
+				Optional:    true,
+			},
+			"capture_headers": schema.BoolAttribute{
+				Description: "Add request headers to events, in the __headers field",
+				Optional:    true,
+			},
+			"activity_log_sample_rate": schema.Float32Attribute{
+				Description: "How often request activity is logged at the info level, e.g. 10 logs every 10th request",
+				Optional:    true,
+			},
+			"request_timeout": schema.Float32Attribute{
+				Description: "Seconds to wait for an incoming request to complete before aborting it, 0 to disable",
+				Optional:    true,
+			},
+			"socket_timeout": schema.Float32Attribute{
+				Description: "Seconds to wait before assuming an inactive socket has timed out, 0 to wait forever",
+				Optional:    true,
+			},
+			"keep_alive_timeout": schema.Float32Attribute{
+				Description: "Seconds to wait for additional data after the last response before closing the socket",
+				Optional:    true,
+			},
+			"enable_health_check": schema.BoolAttribute{
+				Description: "Expose a health check endpoint on this input",
+				Optional:    true,
+			},
+			"ip_allowlist_regex": schema.StringAttribute{
+				Description: "Regex matching IP addresses whose requests are processed, unless also denylisted",
+				Optional:    true,
+			},
+			"ip_denylist_regex": schema.StringAttribute{
+				Description: "Regex matching IP addresses whose requests are ignored. Takes precedence over the allowlist",
+				Optional:    true,
+			},
+			"splunk_hec_api": schema.StringAttribute{
+				Description: "Absolute path to listen on for Splunk HEC API requests, e.g. /services/collector",
+				Required:    true,
+			},
+			"splunk_hec_acks": schema.BoolAttribute{
+				Description: "Enable Splunk HEC acknowledgements",
+				Optional:    true,
+			},
+			"allowed_indexes": schema.ListAttribute{
+				Description: "Values allowed in the HEC event index field. Supports wildcards, leave unset to skip validation",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"breaker_rulesets": schema.ListAttribute{
+				Description: "Event breaking rulesets applied, in order, to the input data stream",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"stale_channel_flush_ms": schema.Float32Attribute{
+				Description: "Milliseconds the Event Breaker waits for new data on a channel before flushing it",
+				Optional:    true,
+			},
+			"use_fwd_timezone": schema.BoolAttribute{
+				Description: "Use forwarder-provided metadata to determine the time zone when it can't be inferred from the event",
+				Optional:    true,
+			},
+			"drop_control_fields": schema.BoolAttribute{
+				Description: "Drop Splunk control fields such as crcSalt and _savedPort instead of storing them in __ctrlFields",
+				Optional:    true,
+			},
+			"extract_metrics": schema.BoolAttribute{
+				Description: "Extract Splunk-generated metrics as Cribl metrics",
+				Optional:    true,
+			},
+			"emit_token_metrics": schema.BoolAttribute{
+				Description: "Emit per-token and summary request metrics",
+				Optional:    true,
+			},
+			"access_control_allow_origin": schema.ListAttribute{
+				Description: "HTTP origins to send CORS Access-Control-Allow-* headers to. Supports wildcards",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"access_control_allow_headers": schema.ListAttribute{
+				Description: "HTTP headers sent to allowed origins in a CORS preflight response, * for all",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"metadata": metadataAttribute(),
+		},
+	}
+}
+
+func (r *criblInputSplunkHecResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.InputSplunkHec
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for idx, token := range data.AuthTokens {
+		if token.AuthType.IsUnknown() {
+			continue
+		}
+		tokenPath := path.Root("auth_tokens").AtListIndex(idx)
+		switch token.AuthType.ValueString() {
+		case "", "manual":
+			if token.Token.IsNull() && !token.Token.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					tokenPath.AtName("token"),
+					"Missing auth token",
+					"token must be set when auth_type is manual.",
+				)
+			}
+		case "secret":
+			if token.TokenSecret.IsNull() && !token.TokenSecret.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					tokenPath.AtName("token_secret"),
+					"Missing auth token secret",
+					"token_secret must be set when auth_type is secret.",
+				)
+			}
+		default:
+			resp.Diagnostics.AddAttributeError(
+				tokenPath.AtName("auth_type"),
+				"Invalid auth type",
+				fmt.Sprintf("auth_type must be manual or secret, got %q.", token.AuthType.ValueString()),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(models.ValidateConnections(data.SendToRoutes, data.Connections)...)
+}
+
+func (r *criblInputSplunkHecResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.InputSplunkHec
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputSplunkHec())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PostSystemInputs(ctx, cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create input splunk hec in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputSplunkHecResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.InputSplunkHec
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputSplunkHec())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PatchSystemInputsId(ctx, data.ID.ValueString(), cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update input splunk hec in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputSplunkHecResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.InputSplunkHec
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete splunk hec input from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblInputSplunkHecResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.InputSplunkHec
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputRes, err := r.client.GetSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch input from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if inputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.InputSplunkHec `json:"items"`
+	}{}
+	if err := cribl.HandleResult(inputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize input response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(state.FromCriblInputSplunkHec(tmp.Items[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	common.ReadBack(ctx, req, resp, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblInputSplunkHecResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp)
+}
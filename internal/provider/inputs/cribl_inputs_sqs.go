@@ -0,0 +1,277 @@
+package inputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
+)
+
+type criblInputSqsResource struct {
+	client *cribl.Client
+}
+
+func NewCriblInputSqsResource() resource.Resource {
+	return &criblInputSqsResource{}
+}
+
+func (r *criblInputSqsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblInputSqsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_input_sqs"
+}
+
+func (r *criblInputSqsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Input Id",
+			Required:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description",
+			Optional:    true,
+		},
+		"disabled": schema.BoolAttribute{
+			Description: "Disabled",
+			Optional:    true,
+		},
+		"environment": schema.StringAttribute{
+			Description: "Optionally, enable this config only on a specified Git branch",
+			Optional:    true,
+		},
+		"pipeline": schema.StringAttribute{
+			Description: "Pipeline to process data from this source before sending it through the Routes",
+			Optional:    true,
+		},
+		"stream_tags": streamTagsAttribute(),
+		"send_to_routes": schema.BoolAttribute{
+			Description: "Send data to Routes, or directly to the Destinations in connections",
+			Optional:    true,
+		},
+		"connections": connectionsAttribute(),
+		"pq_enabled": schema.BoolAttribute{
+			Description: "Use a disk queue to minimize data loss when connected services block",
+			Optional:    true,
+		},
+		"pq": pqAttribute(),
+		"queue_name": schema.StringAttribute{
+			Description: "Name, URL or ARN of the SQS queue to read events from",
+			Required:    true,
+		},
+		"queue_type": schema.StringAttribute{
+			Description: "Type of the SQS queue, standard or fifo",
+			Required:    true,
+		},
+		"create_queue": schema.BoolAttribute{
+			Description: "Create the queue if it does not exist",
+			Optional:    true,
+		},
+		"aws_account_id": schema.StringAttribute{
+			Description: "SQS queue owner's AWS account ID. Leave empty if the queue is in the same account",
+			Optional:    true,
+		},
+		"region": schema.StringAttribute{
+			Description: "AWS region the queue is located in",
+			Optional:    true,
+		},
+		"endpoint": schema.StringAttribute{
+			Description: "Service endpoint. If empty, defaults to the AWS regional endpoint",
+			Optional:    true,
+		},
+		"signature_version": schema.StringAttribute{
+			Description: "Signature version to use for signing requests, v2 or v4",
+			Optional:    true,
+		},
+		"reuse_connections": schema.BoolAttribute{
+			Description: "Reuse connections between requests, which can improve performance",
+			Optional:    true,
+		},
+		"reject_unauthorized": schema.BoolAttribute{
+			Description: "Reject certificates that cannot be verified against a valid CA",
+			Optional:    true,
+		},
+		"max_messages": schema.Float32Attribute{
+			Description: "Maximum number of messages SQS should return in a poll request, 1 to 10",
+			Optional:    true,
+		},
+		"visibility_timeout": schema.Float32Attribute{
+			Description: "Seconds a received message stays hidden from other receivers before it is returned to the queue",
+			Optional:    true,
+		},
+		"num_receivers": schema.Float32Attribute{
+			Description: "Number of receiver processes to run per Worker Process",
+			Optional:    true,
+		},
+		"poll_timeout": schema.Float32Attribute{
+			Description: "Seconds to wait for events before polling again",
+			Optional:    true,
+		},
+		"metadata": metadataAttribute(),
+	}
+	maps.Copy(attributes, common.AWSAuthAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl Amazon SQS source",
+		Attributes:  attributes,
+	}
+}
+
+func (r *criblInputSqsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.InputSqs
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch data.QueueType.ValueString() {
+	case "", "standard", "fifo":
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("queue_type"),
+			"Invalid queue type",
+			fmt.Sprintf("queue_type must be standard or fifo, got %q.", data.QueueType.ValueString()),
+		)
+	}
+
+	resp.Diagnostics.Append(data.AWSAuth.Validate()...)
+
+	resp.Diagnostics.Append(models.ValidateConnections(data.SendToRoutes, data.Connections)...)
+}
+
+func (r *criblInputSqsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.InputSqs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputSqs())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PostSystemInputs(ctx, cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create input sqs in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputSqsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.InputSqs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputSqs())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PatchSystemInputsId(ctx, data.ID.ValueString(), cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update input sqs in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputSqsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.InputSqs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete sqs input from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblInputSqsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.InputSqs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputRes, err := r.client.GetSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch input from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if inputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.InputSqs `json:"items"`
+	}{}
+	if err := cribl.HandleResult(inputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize input response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	state.FromCriblInputSqs(tmp.Items[0])
+	common.ReadBack(ctx, req, resp, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblInputSqsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp)
+}
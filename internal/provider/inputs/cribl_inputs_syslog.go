@@ -0,0 +1,296 @@
+package inputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
+)
+
+type criblInputSyslogResource struct {
+	client *cribl.Client
+}
+
+func NewCriblInputSyslogResource() resource.Resource {
+	return &criblInputSyslogResource{}
+}
+
+func (r *criblInputSyslogResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblInputSyslogResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_input_syslog"
+}
+
+func (r *criblInputSyslogResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl syslog source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Input Id",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Disabled",
+				Optional:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "Optionally, enable this config only on a specified Git branch",
+				Optional:    true,
+			},
+			"pipeline": schema.StringAttribute{
+				Description: "Pipeline to process data from this source before sending it through the Routes",
+				Optional:    true,
+			},
+			"stream_tags": streamTagsAttribute(),
+			"send_to_routes": schema.BoolAttribute{
+				Description: "Send data to Routes, or directly to the Destinations in connections",
+				Optional:    true,
+			},
+			"connections": connectionsAttribute(),
+			"pq_enabled": schema.BoolAttribute{
+				Description: "Use a disk queue to minimize data loss when connected services block",
+				Optional:    true,
+			},
+			"pq": pqAttribute(),
+			"host": schema.StringAttribute{
+				Description: "Address to bind on, e.g. 0.0.0.0 for all IPv4 addresses",
+				Required:    true,
+			},
+			"udp_port": schema.Float32Attribute{
+				Description: "UDP port to listen on. Not required if listening on TCP",
+				Optional:    true,
+			},
+			"tcp_port": schema.Float32Attribute{
+				Description: "TCP port to listen on. Not required if listening on UDP",
+				Optional:    true,
+			},
+			"max_buffer_size": schema.Float32Attribute{
+				Description: "Maximum number of events to buffer when downstream is blocking. Only applies to UDP",
+				Optional:    true,
+			},
+			"ip_whitelist_regex": schema.StringAttribute{
+				Description: "Regex matching IP addresses that are allowed to send data",
+				Optional:    true,
+			},
+			"timestamp_timezone": schema.StringAttribute{
+				Description: "Timezone to assign to timestamps without timezone info",
+				Optional:    true,
+			},
+			"single_msg_udp_packets": schema.BoolAttribute{
+				Description: "Treat each UDP packet as a full syslog message",
+				Optional:    true,
+			},
+			"enable_proxy_header": schema.BoolAttribute{
+				Description: "Enable if the connection is proxied by a device that supports Proxy Protocol V1 or V2",
+				Optional:    true,
+			},
+			"keep_fields_list": schema.ListAttribute{
+				Description: "Wildcard list of fields to keep from source data, * keeps all",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"octet_counting": schema.BoolAttribute{
+				Description: "Enable if incoming messages use octet counting per RFC 6587",
+				Optional:    true,
+			},
+			"infer_framing": schema.BoolAttribute{
+				Description: "Infer the syslog framing of incoming messages",
+				Optional:    true,
+			},
+			"strictly_infer_octet_counting": schema.BoolAttribute{
+				Description: "Only infer octet counting for messages that comply with RFC 5424",
+				Optional:    true,
+			},
+			"allow_non_standard_app_name": schema.BoolAttribute{
+				Description: "Allow hyphens in the app name of RFC 3164 messages",
+				Optional:    true,
+			},
+			"max_active_cxn": schema.Float32Attribute{
+				Description: "Maximum number of active TCP connections per Worker Process, 0 for unlimited",
+				Optional:    true,
+			},
+			"socket_idle_timeout": schema.Float32Attribute{
+				Description: "Seconds to wait before closing an inactive socket, 0 to disable",
+				Optional:    true,
+			},
+			"socket_ending_max_wait": schema.Float32Attribute{
+				Description: "Seconds to wait for a client to close its end of the connection, 0 to disable",
+				Optional:    true,
+			},
+			"socket_max_lifespan": schema.Float32Attribute{
+				Description: "Maximum seconds a socket can remain open, even if active, 0 to disable",
+				Optional:    true,
+			},
+			"enable_load_balancing": schema.BoolAttribute{
+				Description: "Load balance traffic across all Worker Processes",
+				Optional:    true,
+			},
+			"udp_socket_rx_buf_size": schema.Float32Attribute{
+				Description: "SO_RCVBUF for the UDP socket, in bytes. Leave unset to use the OS default",
+				Optional:    true,
+			},
+			"tls":      tlsAttribute(),
+			"metadata": metadataAttribute(),
+		},
+	}
+}
+
+func (r *criblInputSyslogResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.InputSyslog
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.UDPPort.IsNull() && data.TCPPort.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("udp_port"),
+			"Missing syslog port",
+			"At least one of udp_port or tcp_port must be set.",
+		)
+	}
+
+	resp.Diagnostics.Append(models.ValidateConnections(data.SendToRoutes, data.Connections)...)
+}
+
+func (r *criblInputSyslogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.InputSyslog
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputSyslog())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PostSystemInputs(ctx, cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create input syslog in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputSyslogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.InputSyslog
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputBytes, err := json.Marshal(data.ToCriblInputSyslog())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal input request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	inputRes, err := r.client.PatchSystemInputsId(ctx, data.ID.ValueString(), cribl.Input{
+		Union: json.RawMessage(inputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(inputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update input syslog in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblInputSyslogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.InputSyslog
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete syslog input from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblInputSyslogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.InputSyslog
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputRes, err := r.client.GetSystemInputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch input from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if inputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.InputSyslog `json:"items"`
+	}{}
+	if err := cribl.HandleResult(inputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize input response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	state.FromCriblInputSyslog(tmp.Items[0])
+	common.ReadBack(ctx, req, resp, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblInputSyslogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp)
+}
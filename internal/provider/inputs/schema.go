@@ -0,0 +1,193 @@
+package inputs
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// attributes shared by most source types
+
+func streamTagsAttribute() schema.Attribute {
+	return schema.ListAttribute{
+		Description: "Tags for filtering and grouping in Cribl",
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+}
+
+func connectionsAttribute() schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: "Direct connections to Destinations, optionally via a Pipeline or a Pack",
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"output": schema.StringAttribute{
+					Description: "Destination to send data to",
+					Required:    true,
+				},
+				"pipeline": schema.StringAttribute{
+					Description: "Pipeline or Pack to process data with before sending it",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func metadataAttribute() schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: "Fields to add to events from this input",
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Field name",
+					Required:    true,
+				},
+				"value": schema.StringAttribute{
+					Description: "JavaScript expression to compute the field's value, enclosed in quotes or backticks",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+func pqAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "Persistent queue settings, used when pq_enabled is set",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"mode": schema.StringAttribute{
+				Description: "smart writes to disk only under backpressure, always writes every event to the queue first",
+				Optional:    true,
+			},
+			"max_buffer_size": schema.Float32Attribute{
+				Description: "Maximum number of events to hold in memory before writing them to disk",
+				Optional:    true,
+			},
+			"commit_frequency": schema.Float32Attribute{
+				Description: "Number of events to send downstream before committing that they have been read",
+				Optional:    true,
+			},
+			"max_file_size": schema.StringAttribute{
+				Description: "Maximum size of each queue file, e.g. 1 MB",
+				Optional:    true,
+			},
+			"max_size": schema.StringAttribute{
+				Description: "Maximum disk space the queue can consume per Worker Process, e.g. 5GB",
+				Optional:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Location of the queue files",
+				Optional:    true,
+			},
+			"compress": schema.StringAttribute{
+				Description: "Codec used to compress the persisted data, none or gzip",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func tlsAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "TLS settings for incoming connections",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"disabled": schema.BoolAttribute{
+				Description: "Disable TLS",
+				Optional:    true,
+			},
+			"certificate_name": schema.StringAttribute{
+				Description: "Name of a predefined certificate",
+				Optional:    true,
+			},
+			"priv_key_path": schema.StringAttribute{
+				Description: "Path on server containing the private key to use, PEM format",
+				Optional:    true,
+			},
+			"passphrase": schema.StringAttribute{
+				Description: "Passphrase to decrypt the private key",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"cert_path": schema.StringAttribute{
+				Description: "Path on server containing the certificates to use, PEM format",
+				Optional:    true,
+			},
+			"ca_path": schema.StringAttribute{
+				Description: "Path on server containing CA certificates to use, PEM format",
+				Optional:    true,
+			},
+			"request_cert": schema.BoolAttribute{
+				Description: "Require clients to present their certificates",
+				Optional:    true,
+			},
+			"reject_unauthorized": schema.BoolAttribute{
+				Description: "Reject client certificates that are not authorized by the configured CAs",
+				Optional:    true,
+			},
+			"common_name_regex": schema.StringAttribute{
+				Description: "Regex matching allowable common names in client certificates' subject",
+				Optional:    true,
+			},
+			"min_version": schema.StringAttribute{
+				Description: "Minimum TLS version to accept, e.g. TLSv1.2",
+				Optional:    true,
+			},
+			"max_version": schema.StringAttribute{
+				Description: "Maximum TLS version to accept, e.g. TLSv1.3",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func preprocessAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "Feed the data through a custom command before it's processed. Setting the block enables it",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"command": schema.StringAttribute{
+				Description: "Command to feed the data through, via stdin, and read the processed output from, via stdout",
+				Required:    true,
+			},
+			"args": schema.ListAttribute{
+				Description: "Arguments to add to the command",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func authTokensAttribute() schema.Attribute {
+	return schema.ListAttribute{
+		Description: "Shared secrets clients must provide in the Authorization header. If empty, unauthorized access is permitted",
+		Optional:    true,
+		Sensitive:   true,
+		ElementType: types.StringType,
+	}
+}
+
+func authTokensExtAttribute() schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: "Shared secrets clients must provide in the Authorization header, with fields to add to events sent with them",
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"token": schema.StringAttribute{
+					Description: "Shared secret clients must provide",
+					Required:    true,
+					Sensitive:   true,
+				},
+				"description": schema.StringAttribute{
+					Description: "Token description",
+					Optional:    true,
+				},
+				"metadata": metadataAttribute(),
+			},
+		},
+	}
+}
@@ -0,0 +1,184 @@
+package outputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+)
+
+type criblOutputResource struct {
+	client *cribl.Client
+}
+
+func NewCriblOutputResource() resource.Resource {
+	return &criblOutputResource{}
+}
+
+func (r *criblOutputResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblOutputResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_output"
+}
+
+func (r *criblOutputResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl destination of any type. Use this when there is no typed cribl_output_* resource for the destination",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Output Id",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Output type, e.g. splunk_lb, kafka or webhook",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.DynamicAttribute{
+				Description: "Output settings as an object using Cribl's attribute names, e.g. { url = \"https://example.com\", method = \"POST\" }. Settings Cribl fills in with defaults are ignored unless set here",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *criblOutputResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.Output
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := data.ToCriblOutput()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal output request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	outputRes, err := r.client.PostSystemOutputs(ctx, output, r.client.RequestEditors...)
+	if err := cribl.HandleResult(outputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create output in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblOutputResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.Output
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := data.ToCriblOutput()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal output request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	outputRes, err := r.client.PatchSystemOutputsId(ctx, data.ID.ValueString(), output, r.client.RequestEditors...)
+	if err := cribl.HandleResult(outputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update output in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblOutputResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.Output
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemOutputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete output from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblOutputResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.Output
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputRes, err := r.client.GetSystemOutputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch output from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if outputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []json.RawMessage `json:"items"`
+	}{}
+	if err := cribl.HandleResult(outputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize output response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(state.FromCriblOutput(ctx, tmp.Items[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblOutputResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
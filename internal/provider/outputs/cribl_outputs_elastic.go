@@ -0,0 +1,376 @@
+package outputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
+)
+
+type criblOutputElasticResource struct {
+	client *cribl.Client
+}
+
+func NewCriblOutputElasticResource() resource.Resource {
+	return &criblOutputElasticResource{}
+}
+
+func (r *criblOutputElasticResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblOutputElasticResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_output_elastic"
+}
+
+func (r *criblOutputElasticResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl Elasticsearch destination",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique ID for this output",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of this output",
+				Optional:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "Optionally, enable this config only on a specified Git branch",
+				Optional:    true,
+			},
+			"pipeline": schema.StringAttribute{
+				Description: "Pipeline to process data before sending it out to this output",
+				Optional:    true,
+			},
+			"stream_tags":   streamTagsAttribute(),
+			"system_fields": systemFieldsAttribute(),
+			"url": schema.StringAttribute{
+				Description: "Cloud ID or bulk API URL of the cluster, e.g. http://elastic:9200/_bulk. Conflicts with urls",
+				Optional:    true,
+			},
+			"urls": loadBalancedURLsAttribute(),
+			"exclude_self": schema.BoolAttribute{
+				Description: "Exclude all IPs of the current host from resolved hostnames",
+				Optional:    true,
+			},
+			"dns_resolve_period_sec": schema.Float32Attribute{
+				Description: "Re-resolve hostnames every this many seconds and pick up destinations from A records",
+				Optional:    true,
+			},
+			"load_balance_stats_period_sec": schema.Float32Attribute{
+				Description: "Seconds of traffic stats to keep for load balancing",
+				Optional:    true,
+			},
+			"use_round_robin_dns": schema.BoolAttribute{
+				Description: "Cycle through all addresses returned by DNS, for non load balanced destinations",
+				Optional:    true,
+			},
+			"index": schema.StringAttribute{
+				Description: "JavaScript expression for the index or data stream to send events to, e.g. 'logs'. Overridden by an event's __index field",
+				Required:    true,
+			},
+			"doc_type": schema.StringAttribute{
+				Description: "Document type for events. Overridden by an event's __type field",
+				Optional:    true,
+			},
+			"elastic_pipeline": schema.StringAttribute{
+				Description: "Elasticsearch ingest pipeline to send events through",
+				Optional:    true,
+			},
+			"elastic_version": schema.StringAttribute{
+				Description: "Elasticsearch version used to format events, auto, 6 or 7",
+				Optional:    true,
+			},
+			"write_action": schema.StringAttribute{
+				Description: "Bulk action used to write events, index or create. Must be create for data streams",
+				Optional:    true,
+			},
+			"include_doc_id": schema.BoolAttribute{
+				Description: "Send document IDs. Disable for time series data streams or to let Elastic generate IDs",
+				Optional:    true,
+			},
+			"retry_partial_errors": schema.BoolAttribute{
+				Description: "Retry events that failed within an otherwise successful bulk request",
+				Optional:    true,
+			},
+			"auth": schema.SingleNestedAttribute{
+				Description: "Authentication to use. Setting the block enables authentication",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"auth_type": schema.StringAttribute{
+						Description: "manual for basic auth, secret for a stored credentials secret, manualAPIKey for an API key, textSecret for an API key in a stored text secret",
+						Required:    true,
+					},
+					"username": schema.StringAttribute{
+						Description: "Username for basic auth, used with manual",
+						Optional:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "Password for basic auth, used with manual",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"credentials_secret": schema.StringAttribute{
+						Description: "Stored secret holding the username and password, used with secret",
+						Optional:    true,
+					},
+					"api_key": schema.StringAttribute{
+						Description: "API key, used with manualAPIKey",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"text_secret": schema.StringAttribute{
+						Description: "Stored text secret holding the API key, used with textSecret",
+						Optional:    true,
+					},
+				},
+			},
+			"extra_http_headers": extraHTTPFieldsAttribute("Headers to add to every request", false),
+			"extra_params":       extraHTTPFieldsAttribute("Extra query parameters to add to every request", false),
+			"safe_headers": schema.ListAttribute{
+				Description: "Headers that are safe to log in plain text",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"failed_request_logging_mode": schema.StringAttribute{
+				Description: "Data to log when a request fails, none, payload or payloadAndHeaders",
+				Optional:    true,
+			},
+			"compress": schema.BoolAttribute{
+				Description: "Compress the payload body before sending",
+				Optional:    true,
+			},
+			"concurrency": schema.Float32Attribute{
+				Description: "Maximum number of ongoing requests before blocking",
+				Optional:    true,
+			},
+			"max_payload_size_kb": schema.Float32Attribute{
+				Description: "Maximum size of the request body in KB",
+				Optional:    true,
+			},
+			"max_payload_events": schema.Float32Attribute{
+				Description: "Maximum number of events in the request body, 0 for unlimited",
+				Optional:    true,
+			},
+			"flush_period_sec": schema.Float32Attribute{
+				Description: "Maximum seconds between requests",
+				Optional:    true,
+			},
+			"timeout_sec": schema.Float32Attribute{
+				Description: "Seconds to wait for a request to complete before canceling it",
+				Optional:    true,
+			},
+			"reject_unauthorized": schema.BoolAttribute{
+				Description: "Reject certificates that are not authorized by a trusted CA",
+				Optional:    true,
+			},
+			"response_retry_settings": responseRetrySettingsAttribute(),
+			"timeout_retry_settings":  timeoutRetrySettingsAttribute(),
+			"response_honor_retry_after_header": schema.BoolAttribute{
+				Description: "Honor Retry-After headers of up to 180 seconds. Takes precedence over the retry settings",
+				Optional:    true,
+			},
+			"on_backpressure": onBackpressureAttribute(),
+			"pq":              pqAttribute(),
+		},
+	}
+}
+
+func (r *criblOutputElasticResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.OutputElastic
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasURL := !data.URL.IsNull()
+	hasURLs := len(data.URLs) > 0
+	switch {
+	case hasURL && hasURLs:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("urls"),
+			"Conflicting endpoint configuration",
+			"Only one of url or urls can be set.",
+		)
+	case !hasURL && !hasURLs:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Missing endpoint configuration",
+			"Exactly one of url or urls must be set.",
+		)
+	}
+
+	if data.Auth == nil || data.Auth.AuthType.IsUnknown() {
+		return
+	}
+	authPath := path.Root("auth")
+	required := map[string][]string{
+		"manual":       {"username", "password"},
+		"secret":       {"credentials_secret"},
+		"manualAPIKey": {"api_key"},
+		"textSecret":   {"text_secret"},
+	}
+	values := map[string]types.String{
+		"username":           data.Auth.Username,
+		"password":           data.Auth.Password,
+		"credentials_secret": data.Auth.CredentialsSecret,
+		"api_key":            data.Auth.APIKey,
+		"text_secret":        data.Auth.TextSecret,
+	}
+	authType := data.Auth.AuthType.ValueString()
+	fields, ok := required[authType]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			authPath.AtName("auth_type"),
+			"Invalid auth type",
+			fmt.Sprintf("auth_type must be one of manual, secret, manualAPIKey or textSecret, got %q.", authType),
+		)
+		return
+	}
+	for _, field := range fields {
+		if values[field].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				authPath.AtName(field),
+				"Missing auth attribute",
+				fmt.Sprintf("%s must be set when auth_type is %s.", field, authType),
+			)
+		}
+	}
+}
+
+func (r *criblOutputElasticResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.OutputElastic
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputBytes, err := json.Marshal(data.ToCriblOutputElastic())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal output request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	outputRes, err := r.client.PostSystemOutputs(ctx, cribl.Output{
+		Union: json.RawMessage(outputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(outputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create output elastic in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblOutputElasticResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.OutputElastic
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputBytes, err := json.Marshal(data.ToCriblOutputElastic())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal output request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	outputRes, err := r.client.PatchSystemOutputsId(ctx, data.ID.ValueString(), cribl.Output{
+		Union: json.RawMessage(outputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(outputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update output elastic in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblOutputElasticResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.OutputElastic
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemOutputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete elastic output from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblOutputElasticResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.OutputElastic
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputRes, err := r.client.GetSystemOutputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch output from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if outputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.OutputElastic `json:"items"`
+	}{}
+	if err := cribl.HandleResult(outputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize output response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	state.FromCriblOutputElastic(tmp.Items[0])
+	common.ReadBack(ctx, req, resp, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblOutputElasticResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp)
+}
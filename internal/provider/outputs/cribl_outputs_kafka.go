@@ -0,0 +1,259 @@
+package outputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
+)
+
+type criblOutputKafkaResource struct {
+	client *cribl.Client
+}
+
+func NewCriblOutputKafkaResource() resource.Resource {
+	return &criblOutputKafkaResource{}
+}
+
+func (r *criblOutputKafkaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblOutputKafkaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_output_kafka"
+}
+
+func (r *criblOutputKafkaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl Kafka destination",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique ID for this output",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of this output",
+				Optional:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "Optionally, enable this config only on a specified Git branch",
+				Optional:    true,
+			},
+			"pipeline": schema.StringAttribute{
+				Description: "Pipeline to process data before sending it out to this output",
+				Optional:    true,
+			},
+			"stream_tags":   streamTagsAttribute(),
+			"system_fields": systemFieldsAttribute(),
+			"brokers": schema.ListAttribute{
+				Description: "Kafka bootstrap servers as host:port, port defaults to 9092",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"topic": schema.StringAttribute{
+				Description: "Topic to publish events to. Can be overridden with the __topicOut field",
+				Required:    true,
+			},
+			"ack": schema.Int64Attribute{
+				Description: "Required acknowledgments: 1 for the leader, -1 for all replicas, 0 for none",
+				Optional:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: "Serialization format of events, json, raw or protobuf",
+				Optional:    true,
+			},
+			"compression": schema.StringAttribute{
+				Description: "Codec used to compress data before sending, none, gzip, snappy or lz4",
+				Optional:    true,
+			},
+			"protobuf_library_id": schema.StringAttribute{
+				Description: "Protobuf definitions to encode events with, used when format is protobuf",
+				Optional:    true,
+			},
+			"max_record_size_kb": schema.Float32Attribute{
+				Description: "Maximum size of each record batch before compression. Must not exceed the brokers' message.max.bytes",
+				Optional:    true,
+			},
+			"flush_event_count": schema.Float32Attribute{
+				Description: "Maximum number of events in a batch before forcing a flush",
+				Optional:    true,
+			},
+			"flush_period_sec": schema.Float32Attribute{
+				Description: "Maximum seconds to wait before forcing a flush",
+				Optional:    true,
+			},
+			"connection_timeout": schema.Float32Attribute{
+				Description: "Milliseconds to wait for a connection to complete",
+				Optional:    true,
+			},
+			"request_timeout": schema.Float32Attribute{
+				Description: "Milliseconds to wait for Kafka to respond to a request",
+				Optional:    true,
+			},
+			"max_retries": schema.Float32Attribute{
+				Description: "Maximum number of retries for failing messages, up to 100",
+				Optional:    true,
+			},
+			"max_back_off": schema.Float32Attribute{
+				Description: "Maximum milliseconds to wait between retries",
+				Optional:    true,
+			},
+			"initial_backoff": schema.Float32Attribute{
+				Description: "Initial retry delay in milliseconds",
+				Optional:    true,
+			},
+			"backoff_rate": schema.Float32Attribute{
+				Description: "Multiplier, 2-20, applied to the retry delay after each failure",
+				Optional:    true,
+			},
+			"authentication_timeout": schema.Float32Attribute{
+				Description: "Milliseconds to wait for Kafka to respond to an authentication request",
+				Optional:    true,
+			},
+			"reauthentication_threshold": schema.Float32Attribute{
+				Description: "Milliseconds before credentials expire during which Cribl may reauthenticate",
+				Optional:    true,
+			},
+			"sasl":            common.KafkaSaslAttribute(),
+			"tls":             common.ClientTLSAttribute(),
+			"schema_registry": common.KafkaSchemaRegistryAttribute(true),
+			"on_backpressure": onBackpressureAttribute(),
+			"pq":              pqAttribute(),
+		},
+	}
+}
+
+func (r *criblOutputKafkaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.OutputKafka
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputBytes, err := json.Marshal(data.ToCriblOutputKafka())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal output request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	outputRes, err := r.client.PostSystemOutputs(ctx, cribl.Output{
+		Union: json.RawMessage(outputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(outputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create output kafka in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblOutputKafkaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.OutputKafka
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputBytes, err := json.Marshal(data.ToCriblOutputKafka())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal output request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	outputRes, err := r.client.PatchSystemOutputsId(ctx, data.ID.ValueString(), cribl.Output{
+		Union: json.RawMessage(outputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(outputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update output kafka in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblOutputKafkaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.OutputKafka
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemOutputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete kafka output from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblOutputKafkaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.OutputKafka
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputRes, err := r.client.GetSystemOutputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch output from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if outputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.OutputKafka `json:"items"`
+	}{}
+	if err := cribl.HandleResult(outputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize output response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	state.FromCriblOutputKafka(tmp.Items[0])
+	common.ReadBack(ctx, req, resp, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblOutputKafkaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp)
+}
@@ -0,0 +1,303 @@
+package outputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
+)
+
+type criblOutputOpenTelemetryResource struct {
+	client *cribl.Client
+}
+
+func NewCriblOutputOpenTelemetryResource() resource.Resource {
+	return &criblOutputOpenTelemetryResource{}
+}
+
+func (r *criblOutputOpenTelemetryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblOutputOpenTelemetryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_output_open_telemetry"
+}
+
+func (r *criblOutputOpenTelemetryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Unique ID for this output",
+			Required:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description of this output",
+			Optional:    true,
+		},
+		"environment": schema.StringAttribute{
+			Description: "Optionally, enable this config only on a specified Git branch",
+			Optional:    true,
+		},
+		"pipeline": schema.StringAttribute{
+			Description: "Pipeline to process data before sending it out to this output",
+			Optional:    true,
+		},
+		"stream_tags":   streamTagsAttribute(),
+		"system_fields": systemFieldsAttribute(),
+		"protocol": schema.StringAttribute{
+			Description: "OTLP transport to use, grpc or http",
+			Optional:    true,
+		},
+		"otlp_version": schema.StringAttribute{
+			Description: "OTLP protocol version to send, 0.10.0 or 1.3.1",
+			Optional:    true,
+		},
+		"endpoint": schema.StringAttribute{
+			Description: "Endpoint to send events to, host:port for gRPC or a URL for HTTP, e.g. otel-collector:4317",
+			Required:    true,
+		},
+		"compress": schema.StringAttribute{
+			Description: "Compression for gRPC requests, none, deflate or gzip",
+			Optional:    true,
+		},
+		"http_compress": schema.StringAttribute{
+			Description: "Compression for HTTP requests, none or gzip",
+			Optional:    true,
+		},
+		"http_traces_endpoint_override": schema.StringAttribute{
+			Description: "URL to send traces to over HTTP instead of {endpoint}/v1/traces",
+			Optional:    true,
+		},
+		"http_metrics_endpoint_override": schema.StringAttribute{
+			Description: "URL to send metrics to over HTTP instead of {endpoint}/v1/metrics",
+			Optional:    true,
+		},
+		"http_logs_endpoint_override": schema.StringAttribute{
+			Description: "URL to send logs to over HTTP instead of {endpoint}/v1/logs",
+			Optional:    true,
+		},
+		"metadata": schema.ListNestedAttribute{
+			Description: "Key-value pairs to send with every gRPC request, values are evaluated once on startup",
+			Optional:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Description: "Key",
+						Required:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "JavaScript expression for the value, use C.Secret to pass stored credentials",
+						Required:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+		},
+		"extra_http_headers": extraHTTPFieldsAttribute("Headers to add to every HTTP request", true),
+		"safe_headers": schema.ListAttribute{
+			Description: "Headers that are safe to log in plain text",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"failed_request_logging_mode": schema.StringAttribute{
+			Description: "Data to log when a request fails, none, payload or payloadAndHeaders",
+			Optional:    true,
+		},
+		"concurrency": schema.Float32Attribute{
+			Description: "Maximum number of ongoing requests before blocking",
+			Optional:    true,
+		},
+		"connection_timeout": schema.Float32Attribute{
+			Description: "Milliseconds to wait for the connection to be established",
+			Optional:    true,
+		},
+		"keep_alive": schema.BoolAttribute{
+			Description: "Keep the connection open after sending a request",
+			Optional:    true,
+		},
+		"keep_alive_time": schema.Float32Attribute{
+			Description: "Seconds between gRPC keepalive pings",
+			Optional:    true,
+		},
+		"max_payload_size_kb": schema.Float32Attribute{
+			Description: "Maximum size of the request body in KB",
+			Optional:    true,
+		},
+		"flush_period_sec": schema.Float32Attribute{
+			Description: "Maximum seconds between requests",
+			Optional:    true,
+		},
+		"timeout_sec": schema.Float32Attribute{
+			Description: "Seconds to wait for a request to complete before canceling it",
+			Optional:    true,
+		},
+		"use_round_robin_dns": schema.BoolAttribute{
+			Description: "Cycle through all addresses returned by DNS",
+			Optional:    true,
+		},
+		"reject_unauthorized": schema.BoolAttribute{
+			Description: "Reject certificates that are not authorized by a trusted CA. The tls block takes precedence",
+			Optional:    true,
+		},
+		"response_retry_settings": responseRetrySettingsAttribute(),
+		"timeout_retry_settings":  timeoutRetrySettingsAttribute(),
+		"response_honor_retry_after_header": schema.BoolAttribute{
+			Description: "Honor Retry-After headers of up to 180 seconds. Takes precedence over the retry settings",
+			Optional:    true,
+		},
+		"tls":             common.ClientTLSAttribute(),
+		"on_backpressure": onBackpressureAttribute(),
+		"pq":              pqAttribute(),
+	}
+	maps.Copy(attributes, common.HTTPAuthAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl OpenTelemetry destination",
+		Attributes:  attributes,
+	}
+}
+
+func (r *criblOutputOpenTelemetryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.OutputOpenTelemetry
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.HTTPAuth.Validate()...)
+}
+
+func (r *criblOutputOpenTelemetryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.OutputOpenTelemetry
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputBytes, err := json.Marshal(data.ToCriblOutputOpenTelemetry())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal output request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	outputRes, err := r.client.PostSystemOutputs(ctx, cribl.Output{
+		Union: json.RawMessage(outputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(outputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create output open telemetry in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblOutputOpenTelemetryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.OutputOpenTelemetry
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputBytes, err := json.Marshal(data.ToCriblOutputOpenTelemetry())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal output request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	outputRes, err := r.client.PatchSystemOutputsId(ctx, data.ID.ValueString(), cribl.Output{
+		Union: json.RawMessage(outputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(outputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update output open telemetry in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblOutputOpenTelemetryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.OutputOpenTelemetry
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemOutputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete open telemetry output from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblOutputOpenTelemetryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.OutputOpenTelemetry
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputRes, err := r.client.GetSystemOutputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch output from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if outputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.OutputOpenTelemetry `json:"items"`
+	}{}
+	if err := cribl.HandleResult(outputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize output response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	state.FromCriblOutputOpenTelemetry(tmp.Items[0])
+	common.ReadBack(ctx, req, resp, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblOutputOpenTelemetryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp)
+}
@@ -4,13 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"net/http"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/noodahl-org/cribl/internal/clients/cribl"
 	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
 )
 
 type criblOutputS3Resource struct {
@@ -122,11 +124,6 @@ func (r *criblOutputS3Resource) Schema(ctx context.Context, req resource.SchemaR
 				Description: "JavaScript expression defining how files are partitioned and organized",
 				Optional:    true,
 			},
-			"partitioning_fields": schema.ListAttribute{
-				Description: "Fields to use for partitioning",
-				Optional:    true,
-				ElementType: types.StringType,
-			},
 			"format": schema.StringAttribute{
 				Description: "Format of the output data",
 				Optional:    true,
@@ -183,33 +180,6 @@ func (r *criblOutputS3Resource) Schema(ctx context.Context, req resource.SchemaR
 				Description: "Buffer size used to write to a file",
 				Optional:    true,
 			},
-			"aws_authentication_method": schema.StringAttribute{
-				Description: "AWS authentication method. Choose Auto to use IAM roles",
-				Optional:    true,
-			},
-			"aws_api_key": schema.StringAttribute{
-				Description: "AWS Access key.",
-				Optional:    true,
-				Sensitive:   true,
-			},
-			"aws_secret_key": schema.StringAttribute{
-				Description: "AWS Secret key.",
-				Optional:    true,
-				Sensitive:   true,
-			},
-			"aws_secret": schema.StringAttribute{
-				Description: "Select or create a stored secret that references your access key and secret key",
-				Optional:    true,
-				Sensitive:   true,
-			},
-			"assume_role_arn": schema.StringAttribute{
-				Description: "Amazon Resource Name (ARN) of the role to assume",
-				Optional:    true,
-			},
-			"assume_role_external_id": schema.StringAttribute{
-				Description: "External ID to use when assuming role",
-				Optional:    true,
-			},
 			"signature_version": schema.StringAttribute{
 				Description: "Signature version to use for signing S3 requests",
 				Optional:    true,
@@ -246,14 +216,6 @@ func (r *criblOutputS3Resource) Schema(ctx context.Context, req resource.SchemaR
 				Description: "Disable if you can access files within the bucket but not the bucket itself",
 				Optional:    true,
 			},
-			"duration_seconds": schema.Float32Attribute{
-				Description: "Duration of the assumed role's session, in seconds. Minimum is 900 (15 minutes), default is 3600 (1 hour), and maximum is 43200 (12 hours)",
-				Optional:    true,
-			},
-			"enable_assume_role": schema.BoolAttribute{
-				Description: "Use Assume Role credentials to access S3",
-				Optional:    true,
-			},
 			"automatic_schema": schema.BoolAttribute{
 				Description: "Automatically calculate the schema based on the events of each Parquet file generated",
 				Optional:    true,
@@ -294,29 +256,37 @@ func (r *criblOutputS3Resource) Schema(ctx context.Context, req resource.SchemaR
 				Description: "Log up to 3 rows that Cribl skips due to data mismatch",
 				Optional:    true,
 			},
-			"default_id": schema.StringAttribute{
-				Description: "Default ID",
-				Required:    true,
+			"key_value_metadata": schema.ListNestedAttribute{
+				Description: "The metadata of files the Destination writes will include the properties you add here as key-value pairs",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "Metadata key",
+							Required:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "Metadata value",
+							Required:    true,
+						},
+					},
+				},
 			},
-			// "key_value_metadata": schema.ListNestedAttribute{
-			// 	Description: "The metadata of files the Destination writes will include the properties you add here as key-value pairs",
-			// 	Optional:    true,
-			// 	NestedObject: schema.NestedAttributeObject{
-			// 		Attributes: map[string]schema.Attribute{
-			// 			"key": schema.StringAttribute{
-			// 				Description: "Metadata key",
-			// 				Required:    true,
-			// 			},
-			// 			"value": schema.StringAttribute{
-			// 				Description: "Metadata value",
-			// 				Required:    true,
-			// 			},
-			// 		},
-			// 	},
-			// },
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, common.AWSAuthAttributes())
+}
+
+func (r *criblOutputS3Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.OutputS3
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.AWSAuth.Validate()...)
 }
+
 func (r *criblOutputS3Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data models.OutputS3
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -400,27 +370,33 @@ func (r *criblOutputS3Resource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	tmp := struct {
-		Items []cribl.OutputS3 `json:"items"`
-	}{}
-	outputRes, err := r.client.GetSystemOutputsId(ctx, data.ID.ValueString())
+	outputRes, err := r.client.GetSystemOutputsId(ctx, data.ID.ValueString(), r.client.RequestEditors...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to fetch output from Cribl",
 			err.Error(),
 		)
+		return
+	}
+	if outputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
 	}
-	if err := cribl.HandleResult(outputRes, err, &tmp); err != nil {
+	tmp := struct {
+		Items []cribl.OutputS3 `json:"items"`
+	}{}
+	if err := cribl.HandleResult(outputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
 		resp.Diagnostics.AddError(
 			"Unable to parse outputs response from Cribl",
-			err.Error(),
+			fmt.Sprintf("%v", err),
 		)
 		return
 	}
 	data.FromCriblOutputS3(tmp.Items[0])
+	common.ReadBack(ctx, req, resp, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *criblOutputS3Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	common.ImportState(ctx, req, resp)
 }
@@ -0,0 +1,351 @@
+package outputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
+)
+
+type criblOutputSplunkLbResource struct {
+	client *cribl.Client
+}
+
+func NewCriblOutputSplunkLbResource() resource.Resource {
+	return &criblOutputSplunkLbResource{}
+}
+
+func (r *criblOutputSplunkLbResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblOutputSplunkLbResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_output_splunk_lb"
+}
+
+func (r *criblOutputSplunkLbResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl Splunk load balanced destination",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique ID for this output",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of this output",
+				Optional:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "Optionally, enable this config only on a specified Git branch",
+				Optional:    true,
+			},
+			"pipeline": schema.StringAttribute{
+				Description: "Pipeline to process data before sending it out to this output",
+				Optional:    true,
+			},
+			"stream_tags":   streamTagsAttribute(),
+			"system_fields": systemFieldsAttribute(),
+			"hosts": schema.ListNestedAttribute{
+				Description: "Splunk indexers to load balance data to. Conflicts with indexer_discovery",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							Description: "Hostname of the indexer",
+							Required:    true,
+						},
+						"port": schema.Float32Attribute{
+							Description: "Port to connect to on the indexer",
+							Required:    true,
+						},
+						"servername": schema.StringAttribute{
+							Description: "Servername to use when establishing a TLS connection to this indexer",
+							Optional:    true,
+						},
+						"tls": schema.StringAttribute{
+							Description: "inherit to use the output's TLS settings, off to disable TLS for this indexer",
+							Optional:    true,
+						},
+						"weight": schema.Float32Attribute{
+							Description: "Relative traffic-handling capability of this indexer, greater than 0",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"indexer_discovery": schema.SingleNestedAttribute{
+				Description: "Discover indexers from a Splunk cluster manager. Conflicts with hosts",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"master_uri": schema.StringAttribute{
+						Description: "Full URI of the Splunk cluster manager, e.g. https://manager:8089",
+						Required:    true,
+					},
+					"site": schema.StringAttribute{
+						Description: "Clustering site to discover indexers from",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("default"),
+					},
+					"refresh_interval_sec": schema.Float32Attribute{
+						Description: "Seconds between indexer list fetches from the cluster manager",
+						Optional:    true,
+						Computed:    true,
+						Default:     float32default.StaticFloat32(300),
+					},
+					"reject_unauthorized": schema.BoolAttribute{
+						Description: "Reject cluster manager certificates that are not authorized by the system's CA",
+						Optional:    true,
+					},
+					"auth_type": schema.StringAttribute{
+						Description: "manual to enter the token directly, secret to reference a stored text secret",
+						Optional:    true,
+					},
+					"auth_token": schema.StringAttribute{
+						Description: "Token used to authenticate to the cluster manager",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"text_secret": schema.StringAttribute{
+						Description: "Stored text secret holding the cluster manager token",
+						Optional:    true,
+					},
+				},
+			},
+			"auth_type": schema.StringAttribute{
+				Description: "manual to enter the token directly, secret to reference a stored text secret",
+				Optional:    true,
+			},
+			"auth_token": schema.StringAttribute{
+				Description: "Shared secret token used when connecting to the indexers",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"text_secret": schema.StringAttribute{
+				Description: "Stored text secret holding the indexer token",
+				Optional:    true,
+			},
+			"tls": common.ClientTLSAttribute(),
+			"dns_resolve_period_sec": schema.Float32Attribute{
+				Description: "Re-resolve hostnames every this many seconds and pick up destinations from A records",
+				Optional:    true,
+			},
+			"load_balance_stats_period_sec": schema.Float32Attribute{
+				Description: "Seconds of traffic stats to keep for load balancing",
+				Optional:    true,
+			},
+			"max_concurrent_senders": schema.Float32Attribute{
+				Description: "Maximum number of concurrent connections per Worker Process, 0 for unlimited",
+				Optional:    true,
+			},
+			"exclude_self": schema.BoolAttribute{
+				Description: "Exclude all IPs of the current host from resolved hostnames",
+				Optional:    true,
+			},
+			"nested_fields": schema.StringAttribute{
+				Description: "How to serialize nested fields into index-time fields, json or none",
+				Optional:    true,
+			},
+			"throttle_rate_per_sec": schema.StringAttribute{
+				Description: "Rate to throttle writes at, e.g. 42 MB. 0 disables throttling",
+				Optional:    true,
+			},
+			"connection_timeout": schema.Float32Attribute{
+				Description: "Milliseconds to wait for a connection to establish before retrying",
+				Optional:    true,
+			},
+			"write_timeout": schema.Float32Attribute{
+				Description: "Milliseconds to wait for a write to complete before assuming the connection is dead",
+				Optional:    true,
+			},
+			"enable_multi_metrics": schema.BoolAttribute{
+				Description: "Output metrics in multiple-metric format, supported in Splunk 8.0 and above",
+				Optional:    true,
+			},
+			"enable_ack": schema.BoolAttribute{
+				Description: "Stop sending data to indexers that are shutting down",
+				Optional:    true,
+			},
+			"log_failed_requests": schema.BoolAttribute{
+				Description: "Log failed requests, to troubleshoot issues with sending data",
+				Optional:    true,
+			},
+			"max_s2s_version": schema.StringAttribute{
+				Description: "Highest S2S protocol version to advertise during handshake, v3 or v4",
+				Optional:    true,
+			},
+			"max_failed_health_checks": schema.Float32Attribute{
+				Description: "Number of failed health checks before the connection is closed, 0 to disable",
+				Optional:    true,
+			},
+			"sender_unhealthy_time_allowance": schema.Float32Attribute{
+				Description: "Milliseconds an endpoint can report blocked before the destination reports unhealthy",
+				Optional:    true,
+			},
+			"on_backpressure": onBackpressureAttribute(),
+			"pq":              pqAttribute(),
+		},
+	}
+}
+
+func (r *criblOutputSplunkLbResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.OutputSplunkLb
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasHosts := len(data.Hosts) > 0
+	hasDiscovery := data.IndexerDiscovery != nil
+	switch {
+	case hasHosts && hasDiscovery:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("indexer_discovery"),
+			"Conflicting indexer configuration",
+			"Only one of hosts or indexer_discovery can be set.",
+		)
+	case !hasHosts && !hasDiscovery:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("hosts"),
+			"Missing indexer configuration",
+			"Exactly one of hosts or indexer_discovery must be set.",
+		)
+	}
+}
+
+func (r *criblOutputSplunkLbResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.OutputSplunkLb
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputBytes, err := json.Marshal(data.ToCriblOutputSplunkLb())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal output request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	outputRes, err := r.client.PostSystemOutputs(ctx, cribl.Output{
+		Union: json.RawMessage(outputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(outputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create output splunk lb in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblOutputSplunkLbResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.OutputSplunkLb
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputBytes, err := json.Marshal(data.ToCriblOutputSplunkLb())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal output request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	outputRes, err := r.client.PatchSystemOutputsId(ctx, data.ID.ValueString(), cribl.Output{
+		Union: json.RawMessage(outputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(outputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update output splunk lb in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblOutputSplunkLbResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.OutputSplunkLb
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemOutputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete splunk lb output from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblOutputSplunkLbResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.OutputSplunkLb
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputRes, err := r.client.GetSystemOutputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch output from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if outputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.OutputSplunkLb `json:"items"`
+	}{}
+	if err := cribl.HandleResult(outputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize output response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	state.FromCriblOutputSplunkLb(tmp.Items[0])
+	common.ReadBack(ctx, req, resp, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblOutputSplunkLbResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp)
+}
@@ -0,0 +1,333 @@
+package outputs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/noodahl-org/cribl/internal/clients/cribl"
+	"github.com/noodahl-org/cribl/internal/clients/cribl/models"
+	"github.com/noodahl-org/cribl/internal/provider/common"
+)
+
+type criblOutputWebhookResource struct {
+	client *cribl.Client
+}
+
+func NewCriblOutputWebhookResource() resource.Resource {
+	return &criblOutputWebhookResource{}
+}
+
+func (r *criblOutputWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cribl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cribl.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *criblOutputWebhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_output_webhook"
+}
+
+func (r *criblOutputWebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Unique ID for this output",
+			Required:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description of this output",
+			Optional:    true,
+		},
+		"environment": schema.StringAttribute{
+			Description: "Optionally, enable this config only on a specified Git branch",
+			Optional:    true,
+		},
+		"pipeline": schema.StringAttribute{
+			Description: "Pipeline to process data before sending it out to this output",
+			Optional:    true,
+		},
+		"stream_tags":   streamTagsAttribute(),
+		"system_fields": systemFieldsAttribute(),
+		"url": schema.StringAttribute{
+			Description: "URL of the webhook endpoint, e.g. http://localhost:10200. Conflicts with urls",
+			Optional:    true,
+		},
+		"urls": loadBalancedURLsAttribute(),
+		"exclude_self": schema.BoolAttribute{
+			Description: "Exclude all IPs of the current host from resolved hostnames",
+			Optional:    true,
+		},
+		"dns_resolve_period_sec": schema.Float32Attribute{
+			Description: "Re-resolve hostnames every this many seconds and pick up destinations from A records",
+			Optional:    true,
+		},
+		"load_balance_stats_period_sec": schema.Float32Attribute{
+			Description: "Seconds of traffic stats to keep for load balancing",
+			Optional:    true,
+		},
+		"use_round_robin_dns": schema.BoolAttribute{
+			Description: "Cycle through all addresses returned by DNS, for non load balanced destinations",
+			Optional:    true,
+		},
+		"method": schema.StringAttribute{
+			Description: "HTTP method used to send events, POST, PUT or PATCH",
+			Optional:    true,
+		},
+		"format": schema.StringAttribute{
+			Description: "How events are formatted before sending, ndjson, json_array, custom or advanced",
+			Optional:    true,
+		},
+		"keep_alive": schema.BoolAttribute{
+			Description: "Keep the connection open after sending a request",
+			Optional:    true,
+		},
+		"custom_source_expression": schema.StringAttribute{
+			Description: "Expression evaluated on each event to build its output, used with the custom format, e.g. `raw=${_raw}`",
+			Optional:    true,
+		},
+		"custom_drop_when_null": schema.BoolAttribute{
+			Description: "Drop events when custom_source_expression evaluates to null",
+			Optional:    true,
+		},
+		"custom_event_delimiter": schema.StringAttribute{
+			Description: "Delimiter inserted between events, used with the custom format",
+			Optional:    true,
+		},
+		"custom_content_type": schema.StringAttribute{
+			Description: "Content type of requests, used with the custom format",
+			Optional:    true,
+		},
+		"custom_payload_expression": schema.StringAttribute{
+			Description: "Expression wrapping each batch, used with the custom format, e.g. `{ \"items\" : [${events}] }`",
+			Optional:    true,
+		},
+		"advanced_content_type": schema.StringAttribute{
+			Description: "Content type of requests, used with the advanced format",
+			Optional:    true,
+		},
+		"format_event_code": schema.StringAttribute{
+			Description: "JavaScript formatting each event, used with the advanced format",
+			Optional:    true,
+		},
+		"format_payload_code": schema.StringAttribute{
+			Description: "JavaScript formatting each batch, used with the advanced format",
+			Optional:    true,
+		},
+		"extra_http_headers": extraHTTPFieldsAttribute("Headers to add to every request", true),
+		"safe_headers": schema.ListAttribute{
+			Description: "Headers that are safe to log in plain text",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"failed_request_logging_mode": schema.StringAttribute{
+			Description: "Data to log when a request fails, none, payload or payloadAndHeaders",
+			Optional:    true,
+		},
+		"compress": schema.BoolAttribute{
+			Description: "Compress the payload body before sending",
+			Optional:    true,
+		},
+		"concurrency": schema.Float32Attribute{
+			Description: "Maximum number of ongoing requests before blocking",
+			Optional:    true,
+		},
+		"max_payload_size_kb": schema.Float32Attribute{
+			Description: "Maximum size of the request body in KB",
+			Optional:    true,
+		},
+		"max_payload_events": schema.Float32Attribute{
+			Description: "Maximum number of events in the request body, 0 for unlimited",
+			Optional:    true,
+		},
+		"flush_period_sec": schema.Float32Attribute{
+			Description: "Maximum seconds between requests",
+			Optional:    true,
+		},
+		"timeout_sec": schema.Float32Attribute{
+			Description: "Seconds to wait for a request to complete before canceling it",
+			Optional:    true,
+		},
+		"total_memory_limit_kb": schema.Float32Attribute{
+			Description: "Maximum total size of batches waiting to be sent in KB, 0 for unlimited",
+			Optional:    true,
+		},
+		"reject_unauthorized": schema.BoolAttribute{
+			Description: "Reject certificates that are not authorized by a trusted CA. The tls block takes precedence",
+			Optional:    true,
+		},
+		"response_retry_settings": responseRetrySettingsAttribute(),
+		"timeout_retry_settings":  timeoutRetrySettingsAttribute(),
+		"response_honor_retry_after_header": schema.BoolAttribute{
+			Description: "Honor Retry-After headers of up to 180 seconds. Takes precedence over the retry settings",
+			Optional:    true,
+		},
+		"tls":             common.ClientTLSAttribute(),
+		"on_backpressure": onBackpressureAttribute(),
+		"pq":              pqAttribute(),
+	}
+	maps.Copy(attributes, common.HTTPAuthAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cribl webhook destination",
+		Attributes:  attributes,
+	}
+}
+
+func (r *criblOutputWebhookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.OutputWebhook
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasURL := !data.URL.IsNull()
+	hasURLs := len(data.URLs) > 0
+	switch {
+	case hasURL && hasURLs:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("urls"),
+			"Conflicting endpoint configuration",
+			"Only one of url or urls can be set.",
+		)
+	case !hasURL && !hasURLs:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Missing endpoint configuration",
+			"Exactly one of url or urls must be set.",
+		)
+	}
+
+	resp.Diagnostics.Append(data.HTTPAuth.Validate()...)
+}
+
+func (r *criblOutputWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.OutputWebhook
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputBytes, err := json.Marshal(data.ToCriblOutputWebhook())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal output request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	outputRes, err := r.client.PostSystemOutputs(ctx, cribl.Output{
+		Union: json.RawMessage(outputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(outputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create output webhook in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblOutputWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data models.OutputWebhook
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputBytes, err := json.Marshal(data.ToCriblOutputWebhook())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to marshal output request to Cribl destination obj",
+			err.Error(),
+		)
+		return
+	}
+	outputRes, err := r.client.PatchSystemOutputsId(ctx, data.ID.ValueString(), cribl.Output{
+		Union: json.RawMessage(outputBytes),
+	}, r.client.RequestEditors...)
+	if err := cribl.HandleResult(outputRes, err, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update output webhook in Cribl",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *criblOutputWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.OutputWebhook
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteSystemOutputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete webhook output from Cribl",
+			err.Error(),
+		)
+	}
+}
+
+func (r *criblOutputWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.OutputWebhook
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	outputRes, err := r.client.GetSystemOutputsId(ctx, state.ID.ValueString(), r.client.RequestEditors...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to fetch output from Cribl",
+			err.Error(),
+		)
+		return
+	}
+	if outputRes.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tmp := struct {
+		Items []cribl.OutputWebhook `json:"items"`
+	}{}
+	if err := cribl.HandleResult(outputRes, err, &tmp); err != nil || len(tmp.Items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to deseralize output response from Cribl",
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+	state.FromCriblOutputWebhook(tmp.Items[0])
+	common.ReadBack(ctx, req, resp, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *criblOutputWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp)
+}
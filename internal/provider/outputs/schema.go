@@ -0,0 +1,151 @@
+package outputs
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// attributes shared by most destination types
+
+func streamTagsAttribute() schema.Attribute {
+	return schema.ListAttribute{
+		Description: "Tags for filtering and grouping in Cribl",
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+}
+
+func systemFieldsAttribute() schema.Attribute {
+	return schema.ListAttribute{
+		Description: "Fields to automatically add to events, such as cribl_pipe. Supports wildcards",
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+}
+
+func onBackpressureAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		Description: "Whether to block, drop or queue events when all receivers are exerting backpressure",
+		Optional:    true,
+	}
+}
+
+func pqAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "Persistent queue settings, used when on_backpressure is queue",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"mode": schema.StringAttribute{
+				Description: "error queues only on non-retryable errors, backpressure also queues under backpressure, always queues every event",
+				Optional:    true,
+			},
+			"max_file_size": schema.StringAttribute{
+				Description: "Maximum size of each queue file, e.g. 1 MB",
+				Optional:    true,
+			},
+			"max_size": schema.StringAttribute{
+				Description: "Maximum disk space the queue can consume per Worker Process, e.g. 5GB",
+				Optional:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Location of the queue files",
+				Optional:    true,
+			},
+			"compress": schema.StringAttribute{
+				Description: "Codec used to compress the persisted data, none or gzip",
+				Optional:    true,
+			},
+			"on_backpressure": schema.StringAttribute{
+				Description: "Whether to block or drop new data when the queue itself is full",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func loadBalancedURLsAttribute() schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: "Endpoints to load balance events across. Conflicts with url",
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"url": schema.StringAttribute{
+					Description: "URL to send events to",
+					Required:    true,
+				},
+				"weight": schema.Float32Attribute{
+					Description: "Relative traffic-handling capability of this endpoint, greater than 0",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func extraHTTPFieldsAttribute(description string, sensitive bool) schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Name",
+					Required:    true,
+				},
+				"value": schema.StringAttribute{
+					Description: "Value",
+					Required:    true,
+					Sensitive:   sensitive,
+				},
+			},
+		},
+	}
+}
+
+func responseRetrySettingsAttribute() schema.Attribute {
+	return schema.ListNestedAttribute{
+		Description: "Retry requests that fail with these response status codes, e.g. 429 or 503",
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"http_status": schema.Float32Attribute{
+					Description: "HTTP response status code that triggers retries",
+					Required:    true,
+				},
+				"initial_backoff": schema.Float32Attribute{
+					Description: "Milliseconds to wait before the first retry",
+					Optional:    true,
+				},
+				"backoff_rate": schema.Float32Attribute{
+					Description: "Base for exponential backoff between retries",
+					Optional:    true,
+				},
+				"max_backoff": schema.Float32Attribute{
+					Description: "Maximum milliseconds to wait between retries",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func timeoutRetrySettingsAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "Retry requests that time out. Setting the block enables timeout retries",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"initial_backoff": schema.Float32Attribute{
+				Description: "Milliseconds to wait before the first retry",
+				Optional:    true,
+			},
+			"backoff_rate": schema.Float32Attribute{
+				Description: "Base for exponential backoff between retries",
+				Optional:    true,
+			},
+			"max_backoff": schema.Float32Attribute{
+				Description: "Maximum milliseconds to wait between retries",
+				Optional:    true,
+			},
+		},
+	}
+}
@@ -137,7 +137,31 @@ func (p *criblProvider) DataSources(_ context.Context) []func() datasource.DataS
 func (p *criblProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewCriblPipelineResource,
+		NewCriblRouteResource,
+		NewCriblRoutesResource,
+		NewCriblLookupResource,
+		NewCriblGlobalVariableResource,
+		NewCriblSecretResource,
+		NewCriblEventBreakerRulesetResource,
+		NewCriblRegexResource,
+		NewCriblGrokPatternFileResource,
+		NewCriblParserResource,
+		inputs.NewCriblInputResource,
 		inputs.NewCriblInputDatagenResource,
+		inputs.NewCriblInputSyslogResource,
+		inputs.NewCriblInputSplunkHecResource,
+		inputs.NewCriblInputKafkaResource,
+		inputs.NewCriblInputOpenTelemetryResource,
+		inputs.NewCriblInputS3Resource,
+		inputs.NewCriblInputSqsResource,
+		inputs.NewCriblInputHttpResource,
+		inputs.NewCriblInputHttpRawResource,
+		outputs.NewCriblOutputResource,
 		outputs.NewCriblOutputS3Resource,
+		outputs.NewCriblOutputSplunkLbResource,
+		outputs.NewCriblOutputKafkaResource,
+		outputs.NewCriblOutputElasticResource,
+		outputs.NewCriblOutputWebhookResource,
+		outputs.NewCriblOutputOpenTelemetryResource,
 	}
 }